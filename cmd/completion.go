@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/config"
+	"github.com/dmitriy-rs/rollercoaster/internal/logger"
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	"github.com/dmitriy-rs/rollercoaster/internal/sequence"
+	"github.com/spf13/cobra"
+)
+
+var completionInstallCmd = &cobra.Command{
+	Use:       "install [bash|zsh|fish|powershell]",
+	Short:     "Install the autocompletion script for the current or specified shell",
+	Long:      "Install the autocompletion script into the default completions directory of the shell.\nThe shell is detected from $SHELL when not specified.",
+	Args:      cobra.MaximumNArgs(1),
+	ValidArgs: []string{"bash", "zsh", "fish", "powershell"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		shell := filepath.Base(os.Getenv("SHELL"))
+		if len(args) > 0 {
+			shell = args[0]
+		}
+		aliases, _ := cmd.Flags().GetStringSlice("alias")
+		force, _ := cmd.Flags().GetBool("force")
+
+		return installCompletion(cmd.Root(), shell, aliases, force)
+	},
+}
+
+func initCompletionCmd() {
+	rootCmd.InitDefaultCompletionCmd()
+	for _, command := range rootCmd.Commands() {
+		if command.Name() == "completion" {
+			completionInstallCmd.Flags().StringSlice("alias", nil, "shell aliases of rollercoaster to complete as well, e.g. --alias r")
+			completionInstallCmd.Flags().Bool("force", false, "overwrite existing alias completion files which were not installed by rollercoaster")
+			command.AddCommand(completionInstallCmd)
+			return
+		}
+	}
+}
+
+// completeTasks completes the task name with all task names and aliases available in the current directory.
+// In a sequence the query after every "+" is completed as well.
+func completeTasks(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) > 0 && args[len(args)-1] != sequence.Separator {
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+
+	// Any output except completions breaks the shell script
+	logger.QUIET = true
+
+	opts := newRunOptions(cmd, config.ReadConfig())
+	managers, err := parseManagers(opts)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	tasks, err := manager.GetManagerTasksFromList(managers, opts.tasks)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	completions := []cobra.Completion{}
+	seen := map[string]bool{}
+	for _, t := range tasks {
+		managerName := (*t.Manager).GetTitle().Name
+		for i, name := range append([]string{t.Name}, t.Aliases...) {
+			if seen[name] || !strings.HasPrefix(name, toComplete) {
+				continue
+			}
+			seen[name] = true
+
+			description := t.Description
+			if i > 0 {
+				description = "alias for " + t.Name
+			}
+			completions = append(completions, cobra.CompletionWithDesc(name, strings.TrimSpace("["+managerName+"] "+description)))
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+func installCompletion(root *cobra.Command, shell string, aliases []string, force bool) error {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+	name := root.Name()
+
+	var script bytes.Buffer
+	var filename, hint string
+	// aliasFiles are completion files loaded lazily by the shell when an alias is completed
+	aliasFiles := map[string]string{}
+	switch shell {
+	case "bash":
+		err = root.GenBashCompletionV2(&script, true)
+		for _, alias := range aliases {
+			fmt.Fprintf(&script, "complete -o default -F __start_%s %s\n", name, alias)
+		}
+		filename = filepath.Join(xdgDir("XDG_DATA_HOME", home, ".local", "share"), "bash-completion", "completions", name)
+		for _, alias := range aliases {
+			aliasFiles[filepath.Join(filepath.Dir(filename), alias)] = "source " + filename + "\n"
+		}
+		hint = "Requires the bash-completion package"
+	case "zsh":
+		err = root.GenZshCompletion(&script)
+		if len(aliases) > 0 {
+			// compinit registers the commands listed in the #compdef header
+			header := "#compdef " + name
+			zshScript := strings.Replace(script.String(), header, header+" "+strings.Join(aliases, " "), 1)
+			script.Reset()
+			script.WriteString(zshScript)
+		}
+		filename = filepath.Join(home, ".zsh", "completions", "_"+name)
+		hint = "Make sure ~/.zsh/completions is in your fpath before compinit is called:\n  fpath=(~/.zsh/completions $fpath)"
+	case "fish":
+		err = root.GenFishCompletion(&script, true)
+		filename = filepath.Join(xdgDir("XDG_CONFIG_HOME", home, ".config"), "fish", "completions", name+".fish")
+		for _, alias := range aliases {
+			aliasFiles[filepath.Join(filepath.Dir(filename), alias+".fish")] = fmt.Sprintf("complete -c %s -w %s\n", alias, name)
+		}
+	case "powershell", "pwsh":
+		err = root.GenPowerShellCompletionWithDesc(&script)
+		for _, alias := range aliases {
+			fmt.Fprintf(&script, "Register-ArgumentCompleter -CommandName '%s' -ScriptBlock ${__%sCompleterBlock}\n", alias, name)
+		}
+		filename = filepath.Join(xdgDir("XDG_CONFIG_HOME", home, ".config"), "powershell", name+".ps1")
+		hint = "Add the following line to your PowerShell $PROFILE:\n  . " + filename
+	default:
+		return fmt.Errorf("unsupported shell '%s', allowed values are: bash, zsh, fish, powershell", shell)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Alias files may belong to other tools, only files with the same content are replaced silently
+	if !force {
+		for aliasFilename, content := range aliasFiles {
+			existing, err := os.ReadFile(aliasFilename)
+			if err == nil && string(existing) != content {
+				return fmt.Errorf("%s already exists, use --force to overwrite it", aliasFilename)
+			}
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filename, script.Bytes(), 0644); err != nil {
+		return err
+	}
+	for aliasFilename, content := range aliasFiles {
+		if err := os.WriteFile(aliasFilename, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("Completion script installed to " + filename)
+	if hint != "" {
+		logger.Info(hint)
+	}
+	return nil
+}
+
+func xdgDir(env string, home string, fallback ...string) string {
+	if dir := os.Getenv(env); dir != "" {
+		return dir
+	}
+	return filepath.Join(append([]string{home}, fallback...)...)
+}
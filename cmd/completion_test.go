@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompleteTasks(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	defer func() { logger.QUIET = false }()
+
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, ".git"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Makefile"), []byte("build:\n\tgo build\n\nlint:\n\tgo vet\n"), 0644))
+	t.Chdir(dir)
+
+	tests := []struct {
+		name       string
+		args       []string
+		toComplete string
+		expect     []string
+	}{
+		{
+			name:       "task name",
+			toComplete: "b",
+			expect:     []string{"build"},
+		},
+		{
+			name:       "all tasks",
+			toComplete: "",
+			expect:     []string{"build", "lint"},
+		},
+		{
+			name:       "query after a sequence separator",
+			args:       []string{"build", "+"},
+			toComplete: "l",
+			expect:     []string{"lint"},
+		},
+		{
+			name:       "task arguments are not completed",
+			args:       []string{"build"},
+			toComplete: "l",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			completions, _ := completeTasks(rootCmd, tt.args, tt.toComplete)
+
+			var names []string
+			for _, completion := range completions {
+				name, _, _ := strings.Cut(completion, "\t")
+				names = append(names, name)
+			}
+			assert.Equal(t, tt.expect, names)
+		})
+	}
+
+	_, err := os.Stat(filepath.Join(home, ".rollercoaster", "config.toml"))
+	assert.True(t, os.IsNotExist(err), "Completion should not create the config file")
+}
+
+func TestInstallCompletion(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, ".config"))
+	defer func() { logger.QUIET = false }()
+	logger.QUIET = true
+
+	completionsDir := filepath.Join(home, ".config", "fish", "completions")
+	aliasFilename := filepath.Join(completionsDir, "r.fish")
+
+	t.Run("installs the script and alias files", func(t *testing.T) {
+		require.NoError(t, installCompletion(rootCmd, "fish", []string{"r"}, false))
+
+		script, err := os.ReadFile(filepath.Join(completionsDir, "rollercoaster.fish"))
+		require.NoError(t, err)
+		assert.Contains(t, string(script), "complete -c rollercoaster")
+
+		alias, err := os.ReadFile(aliasFilename)
+		require.NoError(t, err)
+		assert.Equal(t, "complete -c r -w rollercoaster\n", string(alias))
+	})
+
+	t.Run("reinstalling keeps the same alias files", func(t *testing.T) {
+		assert.NoError(t, installCompletion(rootCmd, "fish", []string{"r"}, false))
+	})
+
+	t.Run("refuses to overwrite a foreign alias file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(aliasFilename, []byte("complete -c r -w ruby\n"), 0644))
+
+		err := installCompletion(rootCmd, "fish", []string{"r"}, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--force")
+
+		alias, err := os.ReadFile(aliasFilename)
+		require.NoError(t, err)
+		assert.Equal(t, "complete -c r -w ruby\n", string(alias), "The existing file should be kept")
+	})
+
+	t.Run("overwrites with force", func(t *testing.T) {
+		require.NoError(t, installCompletion(rootCmd, "fish", []string{"r"}, true))
+
+		alias, err := os.ReadFile(aliasFilename)
+		require.NoError(t, err)
+		assert.Equal(t, "complete -c r -w rollercoaster\n", string(alias))
+	})
+
+	t.Run("unsupported shell", func(t *testing.T) {
+		assert.Error(t, installCompletion(rootCmd, "tcsh", nil, false))
+	})
+}
@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/config"
+	"github.com/dmitriy-rs/rollercoaster/internal/history"
+	"github.com/dmitriy-rs/rollercoaster/internal/logger"
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	configfile "github.com/dmitriy-rs/rollercoaster/internal/manager/config-file"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+	"github.com/spf13/cobra"
+)
+
+// loadHistory reads the run history once, it returns nil when the history is not readable
+var loadHistory = sync.OnceValue(func() *history.History {
+	h, err := history.Load(history.DefaultFilename())
+	if err != nil {
+		logger.Warning("Failed to load history: " + err.Error())
+		return nil
+	}
+	return h
+})
+
+func repoRoot() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	return configfile.FindClosestGitDir(&dir)
+}
+
+// historyScorers rank frequently and recently executed tasks of the current repository higher
+func historyScorers() []manager.TaskScorer {
+	h := loadHistory()
+	if h == nil {
+		return nil
+	}
+	return []manager.TaskScorer{h.Scorer(repoRoot(), time.Now())}
+}
+
+func recordExecution(managerTask *manager.ManagerTask, args []string, start time.Time, duration time.Duration, err error) {
+	h := loadHistory()
+	if h == nil {
+		return
+	}
+
+	exitCode := 0
+	var exitErr *manager.ExitError
+	if errors.As(err, &exitErr) {
+		exitCode = exitErr.Code
+	} else if err != nil {
+		exitCode = 1
+	}
+
+	dir, _ := os.Getwd()
+	entry := history.Entry{
+		RepoRoot: repoRoot(),
+		Dir:      dir,
+		Manager:  (*managerTask.Manager).GetTitle().Name,
+		Source:   history.SourceOf(*managerTask),
+		Task:     managerTask.Name,
+		Args:     args,
+		Time:     start,
+		Duration: duration,
+		ExitCode: exitCode,
+	}
+	if err := h.Append(entry); err != nil {
+		logger.Warning("Failed to save history: " + err.Error())
+	}
+}
+
+// maxHistoryRuns limits the number of distinct past runs shown by the history command
+const maxHistoryRuns = 100
+
+var lastCmd = &cobra.Command{
+	Use:          "last [-- ARGS...]",
+	Short:        "Re-run the most recent task of the current repository",
+	Long:         "Re-run the most recent task of the current repository with the same arguments.\nAdditional arguments are appended to the previous ones, pass them after \"--\" when they look like flags.",
+	Example:      "  rollercoaster last -- --watch",
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opts := newRunOptions(cmd, config.LoadConfig())
+
+		runs, err := findHistoryRuns(opts)
+		if err != nil {
+			return err
+		}
+		if len(runs) == 0 {
+			return errors.New("no previous task found for this repository")
+		}
+
+		err = runs[0].ExecuteTask(nil, args...)
+		exitOnTaskError(err)
+		return err
+	},
+}
+
+var historyCmd = &cobra.Command{
+	Use:          "history",
+	Short:        "Select a previous task of the current repository to re-run",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opts := newRunOptions(cmd, config.LoadConfig())
+
+		runs, err := findHistoryRuns(opts)
+		if err != nil {
+			return err
+		}
+		if len(runs) == 0 {
+			return errors.New("no previous task found for this repository")
+		}
+
+		tasks := make([]manager.ManagerTask, len(runs))
+		for i, run := range runs {
+			var runManager manager.Manager = run
+			tasks[i] = manager.ManagerTask{
+				Task:    run.task(),
+				Manager: &runManager,
+			}
+		}
+
+		selected, parallel, err := renderTasksList(tasks, "", opts.tasks)
+		if err != nil {
+			return err
+		}
+		err = executeHistoryRuns(selected, opts, parallel)
+		exitOnTaskError(err)
+		return err
+	},
+}
+
+// executeHistoryRuns re-runs the selected past runs, several runs are executed like a sequence
+func executeHistoryRuns(selected []manager.ManagerTask, opts *runOptions, parallel bool) error {
+	if len(selected) == 1 {
+		return (*selected[0].Manager).ExecuteTask(&selected[0].Task)
+	}
+
+	results := []stepResult{}
+	for _, managerTask := range selected {
+		if run, ok := (*managerTask.Manager).(*historyRun); ok {
+			results = append(results, stepResult{task: run.target, args: run.entry.Args})
+		}
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	return runTasks(results, opts, parallel)
+}
+
+func init() {
+	rootCmd.AddCommand(lastCmd)
+	rootCmd.AddCommand(historyCmd)
+}
+
+// historyRun is a past execution shown in the tasks list, it behaves like the manager of the executed task
+type historyRun struct {
+	entry  history.Entry
+	target manager.ManagerTask
+	opts   *runOptions
+}
+
+func (r *historyRun) GetTitle() manager.Title {
+	return (*r.target.Manager).GetTitle()
+}
+
+func (r *historyRun) ListTasks() ([]task.Task, error) {
+	return []task.Task{r.task()}, nil
+}
+
+// ExecuteTask re-runs the original task with the recorded arguments followed by args
+func (r *historyRun) ExecuteTask(_ *task.Task, args ...string) error {
+	return executeSingleTask(&r.target, r.opts, append(slices.Clone(r.entry.Args), args...)...)
+}
+
+func (r *historyRun) task() task.Task {
+	name := strings.Join(append([]string{r.entry.Task}, r.entry.Args...), " ")
+	return task.Task{Name: name, Description: describeRun(r.entry, time.Now())}
+}
+
+// describeRun shows when the run started, how long it took and its exit code
+func describeRun(entry history.Entry, now time.Time) string {
+	return fmt.Sprintf("%s (%s) · %s · exit %d",
+		entry.Time.Local().Format(time.DateTime),
+		formatAge(now.Sub(entry.Time)),
+		entry.Duration.Round(100*time.Millisecond),
+		entry.ExitCode,
+	)
+}
+
+// findHistoryRuns returns distinct past runs of the repository from the newest which still can be executed
+func findHistoryRuns(opts *runOptions) ([]*historyRun, error) {
+	h := loadHistory()
+	if h == nil {
+		return nil, errors.New("history is not available")
+	}
+
+	managers, err := parseManagers(opts)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := manager.GetManagerTasksFromList(managers, opts.tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := []*historyRun{}
+	seen := map[string]bool{}
+	for _, entry := range h.Recent(repoRoot()) {
+		key := entry.Manager + "\x00" + entry.Source + "\x00" + entry.Task + "\x00" + strings.Join(entry.Args, "\x00")
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		index := slices.IndexFunc(tasks, entry.Matches)
+		if index == -1 {
+			continue
+		}
+
+		runs = append(runs, &historyRun{entry: entry, target: tasks[index], opts: opts})
+		if len(runs) == maxHistoryRuns {
+			break
+		}
+	}
+	return runs, nil
+}
+
+func formatAge(age time.Duration) string {
+	switch {
+	case age < time.Minute:
+		return "just now"
+	case age < time.Hour:
+		return fmt.Sprintf("%dm ago", int(age.Minutes()))
+	case age < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(age.Hours()/24))
+	}
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/history"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastCmdArgs(t *testing.T) {
+	cmd, args, err := rootCmd.Find([]string{"last", "--", "--watch"})
+	require.NoError(t, err)
+	require.Equal(t, lastCmd, cmd)
+
+	require.NoError(t, cmd.ParseFlags(args))
+	assert.Equal(t, []string{"--watch"}, cmd.Flags().Args(), "Arguments after -- should be passed to the task")
+}
+
+func TestDescribeRun(t *testing.T) {
+	start := time.Date(2025, 3, 14, 9, 26, 53, 0, time.Local)
+	entry := history.Entry{Time: start, Duration: 1234 * time.Millisecond, ExitCode: 2}
+
+	assert.Equal(t, "2025-03-14 09:26:53 (3h ago) · 1.2s · exit 2", describeRun(entry, start.Add(3*time.Hour)))
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/config"
+	"github.com/dmitriy-rs/rollercoaster/internal/logger"
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	"github.com/spf13/cobra"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Print all available tasks without the interactive UI",
+	Long:  "Print all available tasks in the current directory.\nUse --format json or tsv to pipe the output into other tools.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, _ := cmd.Flags().GetString("format")
+		if format != "json" && format != "tsv" && format != "plain" {
+			return fmt.Errorf("unknown format '%s', allowed values are: json, tsv, plain", format)
+		}
+
+		// Warnings of the managers would break the output piped into other tools
+		logger.QUIET = true
+
+		opts := newRunOptions(cmd, config.LoadConfig())
+		managers, err := parseManagers(opts)
+		if err != nil {
+			return err
+		}
+		tasks, err := manager.GetManagerTasksFromList(managers, opts.tasks)
+		if err != nil {
+			return err
+		}
+
+		return writeTasks(os.Stdout, tasks, format)
+	},
+}
+
+func init() {
+	listCmd.Flags().StringP("format", "f", "plain", "output format: json, tsv or plain")
+	rootCmd.AddCommand(listCmd)
+}
+
+type listedTask struct {
+	Manager     string   `json:"manager"`
+	File        string   `json:"file"`
+	Dir         string   `json:"dir"`
+	Name        string   `json:"name"`
+	Aliases     []string `json:"aliases"`
+	Description string   `json:"description"`
+	Summary     string   `json:"summary,omitempty"`
+}
+
+func toListedTasks(tasks []manager.ManagerTask) []listedTask {
+	listed := make([]listedTask, len(tasks))
+	for i, t := range tasks {
+		source := manager.GetSource(*t.Manager)
+		aliases := t.Aliases
+		if aliases == nil {
+			aliases = []string{}
+		}
+		listed[i] = listedTask{
+			Manager:     (*t.Manager).GetTitle().Name,
+			File:        source.Filename,
+			Dir:         source.Dir,
+			Name:        t.Name,
+			Aliases:     aliases,
+			Description: t.Description,
+			Summary:     t.Summary,
+		}
+	}
+	return listed
+}
+
+func writeTasks(w io.Writer, tasks []manager.ManagerTask, format string) error {
+	listed := toListedTasks(tasks)
+
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(listed)
+	case "tsv":
+		for _, t := range listed {
+			fields := []string{t.Manager, t.File, t.Dir, t.Name, strings.Join(t.Aliases, ","), t.Description}
+			for i, field := range fields {
+				fields[i] = sanitizeField(field)
+			}
+			if _, err := fmt.Fprintln(w, strings.Join(fields, "\t")); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, t := range listed {
+			name := t.Name
+			if len(t.Aliases) > 0 {
+				name += " (" + strings.Join(t.Aliases, ", ") + ")"
+			}
+			fields := []string{t.Manager, t.File, t.Dir, name, t.Description}
+			for i, field := range fields {
+				fields[i] = sanitizeField(field)
+			}
+			if _, err := fmt.Fprintln(tw, strings.Join(fields, "\t")); err != nil {
+				return err
+			}
+		}
+		return tw.Flush()
+	}
+}
+
+// sanitizeField keeps every task on a single tab separated line
+func sanitizeField(field string) string {
+	return strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ").Replace(field)
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/logger"
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type listTestManager struct {
+	source manager.Source
+	tasks  []task.Task
+}
+
+func (m *listTestManager) GetTitle() manager.Title {
+	return manager.Title{Name: "task", Description: "Taskfile runner"}
+}
+
+func (m *listTestManager) ListTasks() ([]task.Task, error) { return m.tasks, nil }
+
+func (m *listTestManager) ExecuteTask(_ *task.Task, _ ...string) error { return nil }
+
+func (m *listTestManager) GetSource() manager.Source { return m.source }
+
+func TestWriteTasks(t *testing.T) {
+	var mgr manager.Manager = &listTestManager{source: manager.Source{Filename: "/repo/Taskfile.yml", Dir: "/repo"}}
+	tasks := []manager.ManagerTask{
+		{Task: task.Task{Name: "build", Description: "Build the app", Summary: "Builds the binary", Aliases: []string{"b", "compile"}}, Manager: &mgr},
+		{Task: task.Task{Name: "lint", Description: "Lint\tthe\ncode"}, Manager: &mgr},
+	}
+
+	tests := []struct {
+		format string
+		expect string
+	}{
+		{
+			format: "plain",
+			expect: "task  /repo/Taskfile.yml  /repo  build (b, compile)  Build the app\n" +
+				"task  /repo/Taskfile.yml  /repo  lint                Lint the code\n",
+		},
+		{
+			format: "tsv",
+			expect: "task\t/repo/Taskfile.yml\t/repo\tbuild\tb,compile\tBuild the app\n" +
+				"task\t/repo/Taskfile.yml\t/repo\tlint\t\tLint the code\n",
+		},
+		{
+			format: "json",
+			expect: `[
+  {
+    "manager": "task",
+    "file": "/repo/Taskfile.yml",
+    "dir": "/repo",
+    "name": "build",
+    "aliases": [
+      "b",
+      "compile"
+    ],
+    "description": "Build the app",
+    "summary": "Builds the binary"
+  },
+  {
+    "manager": "task",
+    "file": "/repo/Taskfile.yml",
+    "dir": "/repo",
+    "name": "lint",
+    "aliases": [],
+    "description": "Lint\tthe\ncode"
+  }
+]
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, writeTasks(&buf, tasks, tt.format))
+			assert.Equal(t, tt.expect, buf.String())
+		})
+	}
+}
+
+func TestListCmd_OnlyFormattedOutput(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	defer func() { logger.QUIET = false }()
+
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, ".git"), 0755))
+	taskfile := "version: '3'\n\nincludes:\n  missing: ./nope\n\ntasks:\n  build:\n    cmds:\n      - go build\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Taskfile.yml"), []byte(taskfile), 0644))
+	t.Chdir(dir)
+
+	require.NoError(t, listCmd.Flags().Set("format", "json"))
+	defer func() { _ = listCmd.Flags().Set("format", "plain") }()
+
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+	stdout := os.Stdout
+	os.Stdout = writer
+	runErr := listCmd.RunE(listCmd, nil)
+	os.Stdout = stdout
+	require.NoError(t, writer.Close())
+	output, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, runErr)
+
+	var listed []listedTask
+	require.NoError(t, json.Unmarshal(output, &listed), "Output should be only JSON, got: %s", output)
+	require.Len(t, listed, 1)
+	assert.Equal(t, "build", listed[0].Name)
+}
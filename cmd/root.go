@@ -1,35 +1,70 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"time"
 
 	"github.com/dmitriy-rs/rollercoaster/internal/config"
 	"github.com/dmitriy-rs/rollercoaster/internal/logger"
 	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	configmanager "github.com/dmitriy-rs/rollercoaster/internal/manager/config-manager"
 	"github.com/dmitriy-rs/rollercoaster/internal/manager/parser"
+	"github.com/dmitriy-rs/rollercoaster/internal/sequence"
+	confirm "github.com/dmitriy-rs/rollercoaster/internal/ui/confirm"
 	ui "github.com/dmitriy-rs/rollercoaster/internal/ui/tasks-list"
 	"github.com/spf13/cobra"
 )
 
 var VERSION string = "dev"
 
+const rootExample = `  rollercoaster li                      run the closest match of "li"
+  rollercoaster add lodash              run a task with arguments
+  rollercoaster lint + test unit        run several tasks one by one, each with its own arguments
+  rollercoaster lint test build --      queries before "--" run one by one as well
+  rollercoaster lint test -- --watch    arguments after "--" are passed to the last task
+  rollercoaster add -- lodash -D        pass all arguments after "--" when they include flags`
+
 var rootCmd = &cobra.Command{
-	Use:           "rollercoaster [TASK_NAME|TASK_NAME_QUERY]",
-	Short:         "rollercoaster is a cli tool for running tasks/scripts in current directory",
-	Long:          "rollercoaster is a cli tool for running tasks/scripts in current directory.\nIt allows you to run it without knowing the name of the manager and script.",
-	SilenceErrors: false,
-	Version:       VERSION,
+	Use:               "rollercoaster [TASK_NAME|TASK_NAME_QUERY] [+ TASK_NAME_QUERY...]",
+	Short:             "rollercoaster is a cli tool for running tasks/scripts in current directory",
+	Long:              "rollercoaster is a cli tool for running tasks/scripts in current directory.\nIt allows you to run it without knowing the name of the manager and script.",
+	Example:           rootExample,
+	SilenceErrors:     false,
+	Version:           VERSION,
+	ValidArgsFunction: completeTasks,
+	// Without it cobra rejects task names as unknown subcommands
+	Args: cobra.ArbitraryArgs,
 	Run: func(cmd *cobra.Command, args []string) {
 		cfg := config.LoadConfig()
 		if err := execute(cmd, args, cfg); err != nil {
+			exitOnTaskError(err)
 			logger.Error("", err)
 			os.Exit(1)
 		}
 	},
 }
 
+// exitOnTaskError exits with the code of a failed task, other errors are left to the caller
+func exitOnTaskError(err error) {
+	var exitErr *manager.ExitError
+	if errors.As(err, &exitErr) {
+		// The task already reported its failure, exit with the same code
+		logger.Debug(exitErr.Error())
+		os.Exit(exitErr.Code)
+	}
+}
+
+func init() {
+	rootCmd.PersistentFlags().Bool("current-dir", false, "run tasks in the current directory instead of the directory of the file defining them")
+	rootCmd.PersistentFlags().BoolP("dry-run", "n", false, "print the resolved command, its directory and environment without executing it")
+	rootCmd.Flags().BoolP("parallel", "p", false, "run the tasks of a sequence concurrently with prefixed output")
+}
+
 func Execute() {
+	initCompletionCmd()
 	if err := rootCmd.Execute(); err != nil {
 		// logger.Error("Oops. An error occurred while executing rollercoaster", err)
 		os.Exit(1)
@@ -37,87 +72,169 @@ func Execute() {
 }
 
 func execute(cmd *cobra.Command, args []string, cfg *config.Config) error {
+	opts := newRunOptions(cmd, cfg)
+
+	managers, err := parseManagers(opts)
+	if err != nil {
+		return err
+	}
+	if len(managers) == 0 {
+		return nil
+	}
+
+	steps := sequence.Parse(args, cmd.ArgsLenAtDash())
+	switch len(steps) {
+	case 0:
+		return executeWithoutArgs(managers, opts)
+	case 1:
+		return executeWithArgs(managers, append([]string{steps[0].Query}, steps[0].Args...), opts)
+	default:
+		parallel, _ := cmd.Flags().GetBool("parallel")
+		return executeSequence(managers, steps, opts, parallel)
+	}
+}
+
+// runOptions are the config and flags of a command, passed down to listing, matching and executing tasks
+type runOptions struct {
+	// cfg is nil when the config failed to load
+	cfg *config.Config
+	// tasks are nil without a config, tasks are listed and matched with the defaults then
+	tasks   *manager.Options
+	execute *manager.ExecuteOptions
+}
+
+func newRunOptions(cmd *cobra.Command, cfg *config.Config) *runOptions {
+	opts := &runOptions{cfg: cfg, execute: &manager.ExecuteOptions{}}
+	if cfg != nil {
+		opts.tasks = &manager.Options{
+			HiddenTasks:     cfg.HiddenTasks,
+			Aliases:         cfg.Aliases,
+			MatchWeights:    cfg.MatchWeights,
+			ManagerPriority: cfg.ManagerPriority,
+		}
+		opts.execute.RunInCurrentDir = cfg.RunInCurrentDir
+	}
+	if cmd.Flags().Changed("current-dir") {
+		opts.execute.RunInCurrentDir, _ = cmd.Flags().GetBool("current-dir")
+	}
+	opts.execute.DryRun, _ = cmd.Flags().GetBool("dry-run")
+	return opts
+}
+
+func (opts *runOptions) confirmTasks() []string {
+	if opts.cfg == nil {
+		return nil
+	}
+	return opts.cfg.ConfirmTasks
+}
+
+func parseManagers(opts *runOptions) ([]manager.Manager, error) {
 	dir, err := os.Getwd()
 	if err != nil {
-		return fmt.Errorf("failed to get current working directory: %w", err)
+		return nil, fmt.Errorf("failed to get current working directory: %w", err)
 	}
 
-	// Handle case where config failed to load
 	var defaultJSManager string
-	if cfg != nil {
-		defaultJSManager = cfg.DefaultJSManager
+	if opts.cfg != nil {
+		defaultJSManager = opts.cfg.DefaultJSManager
 	}
 
 	managers, err := parser.ParseManager(&dir, &parser.ParseManagerConfig{
 		DefaultJSManager: defaultJSManager,
 	})
 	if err != nil {
-		return err
-	}
-	if len(managers) == 0 {
-		return nil
+		return nil, err
 	}
 
-	if len(args) == 0 {
-		return executeWithoutArgs(managers)
-	} else {
-		return executeWithArgs(managers, args, cfg)
+	if opts.cfg != nil && len(opts.cfg.Shortcuts) > 0 {
+		managers = append(managers, &configmanager.ConfigManager{
+			Shortcuts:      opts.cfg.Shortcuts,
+			Managers:       slices.Clone(managers),
+			Options:        opts.tasks,
+			ExecuteOptions: opts.execute,
+		})
 	}
+	return managers, nil
 }
 
-func executeWithoutArgs(managers []manager.Manager) error {
-	tasks, err := manager.GetManagerTasksFromList(managers)
+func executeWithoutArgs(managers []manager.Manager, opts *runOptions) error {
+	tasks, err := manager.GetManagerTasksFromList(managers, opts.tasks)
 	if err != nil {
 		return err
 	}
 
-	return handleTasksListUI(tasks, "")
+	return handleTasksListUI(tasks, "", opts)
 }
 
-func executeWithArgs(managers []manager.Manager, args []string, cfg *config.Config) error {
+func executeWithArgs(managers []manager.Manager, args []string, opts *runOptions) error {
 	commandName := args[0]
 	commandArgs := args[1:]
 
-	tasks, err := findTasksWithFallback(managers, commandName, cfg.AutoSelectClosest)
+	tasks, err := findTasksWithFallback(managers, commandName, opts)
 	if err != nil {
-		return handleNoTasksFound(managers)
+		return handleNoTasksFound(managers, opts)
 	}
 
-	return handleTaskSelection(tasks, commandName, commandArgs)
+	return handleTaskSelection(tasks, commandName, commandArgs, opts)
 }
 
-func handleTaskSelection(tasks []manager.ManagerTask, commandName string, commandArgs []string) error {
+func handleTaskSelection(tasks []manager.ManagerTask, commandName string, commandArgs []string, opts *runOptions) error {
 	if len(tasks) == 1 {
-		return executeSingleTask(&tasks[0], commandArgs...)
+		if !opts.execute.DryRun && manager.NeedsConfirmation(tasks[0].Task, commandName, opts.confirmTasks()) {
+			confirmed, err := confirm.Confirm(os.Stdin, os.Stdout, confirmationMessage(&tasks[0], commandName))
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				logger.Info("Cancelled")
+				return nil
+			}
+		}
+		return executeSingleTask(&tasks[0], opts, commandArgs...)
 	} else {
-		return handleTasksListUI(tasks, commandName)
+		return handleTasksListUI(tasks, commandName, opts, commandArgs...)
 	}
 }
 
-func handleTasksListUI(tasks []manager.ManagerTask, initialSelection string) error {
-	selectedManager, selectedTask, err := ui.RenderTasksList(tasks, initialSelection)
+// renderTasksList lets the user pick tasks, replaced in tests
+var renderTasksList = ui.RenderTasksList
+
+// handleTasksListUI lets the user pick tasks and runs them with args
+func handleTasksListUI(tasks []manager.ManagerTask, initialSelection string, opts *runOptions, args ...string) error {
+	selected, parallel, err := renderTasksList(tasks, initialSelection, opts.tasks)
 	if err != nil {
 		return err
 	}
 
-	if selectedManager != nil && selectedTask != nil {
-		return executeSingleTask(&manager.ManagerTask{
-			Manager: selectedManager,
-			Task:    *selectedTask,
-		})
+	switch len(selected) {
+	case 0:
+		return nil
+	case 1:
+		return executeSingleTask(&selected[0], opts, args...)
+	default:
+		results := make([]stepResult, len(selected))
+		for i, managerTask := range selected {
+			results[i] = stepResult{task: managerTask, args: args}
+		}
+		return runTasks(results, opts, parallel)
 	}
-	return nil
 }
 
-func findTasksWithFallback(managers []manager.Manager, commandName string, autoSelectClosest bool) ([]manager.ManagerTask, error) {
+func findTasksWithFallback(managers []manager.Manager, commandName string, opts *runOptions) ([]manager.ManagerTask, error) {
+	autoSelectClosest := opts.cfg == nil || opts.cfg.AutoSelectClosest
 	if autoSelectClosest {
-		closestTask, err := manager.FindClosestTaskFromList(managers, commandName)
+		closestTask, err := manager.FindClosestTaskFromList(managers, commandName, opts.tasks, historyScorers()...)
+		var ambiguousErr *manager.AmbiguousMatchError
+		if errors.As(err, &ambiguousErr) {
+			// Let the user pick the manager
+			return ambiguousErr.Tasks, nil
+		}
 		if err != nil {
 			return nil, err
 		}
 		return []manager.ManagerTask{*closestTask}, nil
 	} else {
-		tasks, err := manager.FindAllClosestTasksFromList(managers, commandName)
+		tasks, err := manager.FindAllClosestTasksFromList(managers, commandName, opts.tasks, historyScorers()...)
 		if err != nil {
 			return nil, err
 		}
@@ -125,12 +242,29 @@ func findTasksWithFallback(managers []manager.Manager, commandName string, autoS
 	}
 }
 
-func handleNoTasksFound(managers []manager.Manager) error {
+func handleNoTasksFound(managers []manager.Manager, opts *runOptions) error {
 	logger.Info("No tasks found")
-	return executeWithoutArgs(managers)
+	return executeWithoutArgs(managers, opts)
 }
 
-func executeSingleTask(managerTask *manager.ManagerTask, args ...string) error {
-	(*managerTask.Manager).ExecuteTask(&managerTask.Task, args...)
-	return nil
+func confirmationMessage(managerTask *manager.ManagerTask, commandName string) string {
+	message := fmt.Sprintf("'%s' matched %s task '%s'.", commandName, (*managerTask.Manager).GetTitle().Name, managerTask.Name)
+	for _, prompt := range managerTask.Prompts {
+		message += " " + prompt
+	}
+	if len(managerTask.Prompts) == 0 {
+		message += " Run it?"
+	}
+	return message
+}
+
+func executeSingleTask(managerTask *manager.ManagerTask, opts *runOptions, args ...string) error {
+	if opts.execute.DryRun {
+		return manager.Execute(managerTask, opts.execute, args...)
+	}
+
+	start := time.Now()
+	err := manager.Execute(managerTask, opts.execute, args...)
+	recordExecution(managerTask, args, start, time.Since(start), err)
+	return err
 }
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/config"
+	"github.com/dmitriy-rs/rollercoaster/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+// runCmd runs tasks named like one of the subcommands, e.g. a "list" script
+var runCmd = &cobra.Command{
+	Use:               "run TASK_NAME_QUERY [ARGS...]",
+	Short:             "Run a task, also when its name is taken by a subcommand like list or history",
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: completeTasks,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := config.LoadConfig()
+		if err := execute(cmd, args, cfg); err != nil {
+			exitOnTaskError(err)
+			logger.Error("", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	runCmd.Flags().BoolP("parallel", "p", false, "run the tasks of a sequence concurrently with prefixed output")
+	rootCmd.AddCommand(runCmd)
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubcommandsShadowTasks(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		expectCmd   string
+		expectQuery []string
+	}{
+		{
+			name:        "task name",
+			args:        []string{"build"},
+			expectCmd:   "rollercoaster",
+			expectQuery: []string{"build"},
+		},
+		{
+			name:        "subcommand wins over a task with the same name",
+			args:        []string{"list"},
+			expectCmd:   "list",
+			expectQuery: []string{},
+		},
+		{
+			name:        "run subcommand",
+			args:        []string{"run", "list"},
+			expectCmd:   "run",
+			expectQuery: []string{"list"},
+		},
+		{
+			name:        "double dash",
+			args:        []string{"--", "history"},
+			expectCmd:   "rollercoaster",
+			expectQuery: []string{"history"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, args, err := rootCmd.Find(tt.args)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectCmd, cmd.Name())
+
+			require.NoError(t, cmd.ParseFlags(args))
+			assert.Equal(t, tt.expectQuery, cmd.Flags().Args())
+		})
+	}
+}
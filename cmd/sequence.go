@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/logger"
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	"github.com/dmitriy-rs/rollercoaster/internal/sequence"
+	confirm "github.com/dmitriy-rs/rollercoaster/internal/ui/confirm"
+)
+
+type stepResult struct {
+	task     manager.ManagerTask
+	args     []string
+	duration time.Duration
+	err      error
+	executed bool
+}
+
+// executeSequence resolves every step before running them one by one until the first failure, or all at once in parallel
+func executeSequence(managers []manager.Manager, steps []sequence.Step, opts *runOptions, parallel bool) error {
+	results := []stepResult{}
+	for _, step := range steps {
+		managerTasks, err := resolveStep(managers, step.Query, opts)
+		if err != nil {
+			return err
+		}
+		if len(managerTasks) == 0 {
+			logger.Info("Cancelled")
+			return nil
+		}
+
+		for i := range managerTasks {
+			managerTask := &managerTasks[i]
+			if !opts.execute.DryRun && manager.NeedsConfirmation(managerTask.Task, step.Query, opts.confirmTasks()) {
+				confirmed, err := confirm.Confirm(os.Stdin, os.Stdout, confirmationMessage(managerTask, step.Query))
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					logger.Info("Cancelled")
+					return nil
+				}
+			}
+			results = append(results, stepResult{task: *managerTask, args: step.Args})
+		}
+	}
+
+	return runTasks(results, opts, parallel)
+}
+
+// runTasks runs resolved tasks one by one until the first failure, or all at once in parallel, and prints a summary
+func runTasks(results []stepResult, opts *runOptions, parallel bool) error {
+	if parallel {
+		if err := executeParallel(results, opts); err != nil {
+			return err
+		}
+	} else {
+		for i := range results {
+			start := time.Now()
+			err := executeSingleTask(&results[i].task, opts, results[i].args...)
+			results[i].duration = time.Since(start)
+			results[i].err = err
+			results[i].executed = true
+			if err != nil {
+				break
+			}
+		}
+	}
+
+	if !opts.execute.DryRun {
+		printSequenceSummary(os.Stdout, results)
+	}
+	for _, result := range results {
+		if result.err != nil {
+			return result.err
+		}
+	}
+	return nil
+}
+
+// executeParallel runs all tasks at once with their output prefixed by the task name
+func executeParallel(results []stepResult, opts *runOptions) error {
+	commands := make([]manager.ParallelCommand, len(results))
+	for i := range results {
+		managerTask := &results[i].task
+		commandManager, ok := (*managerTask.Manager).(manager.CommandManager)
+		if !ok {
+			return fmt.Errorf("%s task '%s' can't run in parallel", (*managerTask.Manager).GetTitle().Name, managerTask.Name)
+		}
+		cmd := commandManager.TaskCommand(&managerTask.Task)
+		cmd.Args = append(cmd.Args, results[i].args...)
+		commands[i] = manager.ParallelCommand{Label: managerTask.Name, Cmd: cmd}
+	}
+
+	start := time.Now()
+	for i, result := range manager.CommandExecuteParallel(commands, opts.execute, os.Stdout, os.Stderr) {
+		results[i].duration = result.Duration
+		results[i].err = result.Err
+		results[i].executed = true
+		if !opts.execute.DryRun {
+			recordExecution(&results[i].task, results[i].args, start, result.Duration, result.Err)
+		}
+	}
+	return nil
+}
+
+// resolveStep finds the tasks of the query like a single task, the user picks one or more tasks when several managers
+// match exactly or the closest match is not selected automatically. It returns no tasks when the user quits the selection.
+func resolveStep(managers []manager.Manager, query string, opts *runOptions) ([]manager.ManagerTask, error) {
+	managerTasks, err := findTasksWithFallback(managers, query, opts)
+	if err != nil || len(managerTasks) == 0 {
+		return nil, fmt.Errorf("no task found for '%s'", query)
+	}
+	if len(managerTasks) == 1 {
+		return managerTasks, nil
+	}
+	selected, _, err := renderTasksList(managerTasks, query, opts.tasks)
+	return selected, err
+}
+
+func printSequenceSummary(w io.Writer, results []stepResult) {
+	_, _ = fmt.Fprintln(w)
+	for _, result := range results {
+		name := fmt.Sprintf("%s (%s)", result.task.Name, (*result.task.Manager).GetTitle().Name)
+		switch {
+		case !result.executed:
+			_, _ = fmt.Fprintf(w, "  - %s skipped\n", name)
+		case result.err != nil:
+			_, _ = fmt.Fprintf(w, "  ✗ %s failed after %s: %s\n", name, result.duration.Round(100*time.Millisecond), result.err)
+		default:
+			_, _ = fmt.Fprintf(w, "  ✓ %s passed in %s\n", name, result.duration.Round(100*time.Millisecond))
+		}
+	}
+}
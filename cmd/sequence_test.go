@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/config"
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveStep_AutoSelectClosest(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	defer func(render func([]manager.ManagerTask, string, *manager.Options) ([]manager.ManagerTask, bool, error)) {
+		renderTasksList = render
+	}(renderTasksList)
+
+	var picked []manager.ManagerTask
+	renderTasksList = func(tasks []manager.ManagerTask, _ string, _ *manager.Options) ([]manager.ManagerTask, bool, error) {
+		picked = tasks
+		return tasks[len(tasks)-1:], false, nil
+	}
+
+	managers := []manager.Manager{&listTestManager{tasks: []task.Task{{Name: "test"}, {Name: "toast"}}}}
+
+	opts := &runOptions{cfg: &config.Config{AutoSelectClosest: true}, execute: &manager.ExecuteOptions{}}
+	resolved, err := resolveStep(managers, "tst", opts)
+	require.NoError(t, err)
+	require.Len(t, resolved, 1)
+	assert.Equal(t, "test", resolved[0].Name, "Closest match should be selected automatically")
+	assert.Nil(t, picked, "Tasks list should not be shown")
+
+	opts.cfg.AutoSelectClosest = false
+	resolved, err = resolveStep(managers, "tst", opts)
+	require.NoError(t, err)
+	require.Len(t, picked, 2, "Every match should be offered")
+	require.Len(t, resolved, 1)
+	assert.Equal(t, "toast", resolved[0].Name, "Picked task should be resolved")
+
+	_, err = resolveStep(managers, "zzz", opts)
+	assert.EqualError(t, err, "no task found for 'zzz'")
+}
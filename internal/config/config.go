@@ -1,25 +1,75 @@
 package config
 
 import (
+	"errors"
+	"io/fs"
 	"os"
 	"path"
+	"path/filepath"
 
 	"github.com/dmitriy-rs/rollercoaster/internal/logger"
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	configfile "github.com/dmitriy-rs/rollercoaster/internal/manager/config-file"
 	"github.com/spf13/viper"
 )
 
 type Config struct {
 	DefaultJSManager  string
 	AutoSelectClosest bool
+	// RunInCurrentDir runs tasks in the invoking directory instead of the directory of their manifest
+	RunInCurrentDir bool
+	// ConfirmTasks are glob patterns of task names which need confirmation when reached by fuzzy matching
+	ConfirmTasks []string
+	// MatchWeights weigh how much the name, aliases and description count when matching a query
+	MatchWeights manager.MatchWeights
+	// ManagerPriority lists manager names preferred when tasks of several managers match equally well
+	ManagerPriority []string
+	// HiddenTasks are glob patterns of task names which are not listed nor matched
+	HiddenTasks []string
+	// Aliases maps additional aliases to task names, aliases are lowercased like every config key
+	Aliases map[string]string
+	// Shortcuts are tasks defined in the config, every step is a task name or a shell command.
+	// Shortcut names are lowercased like every config key.
+	Shortcuts map[string][]string
 }
 
+// ProjectConfigFilename is the project configuration, committed alongside the code, which is merged over the global config
+const ProjectConfigFilename = ".rollercoaster.toml"
+
+// LoadConfig loads the global and project configs, the global config is created with defaults when missing
 func LoadConfig() *Config {
+	return loadConfig(true)
+}
+
+// ReadConfig loads the configs like LoadConfig without creating the global config, e.g. for shell completions
+func ReadConfig() *Config {
+	return loadConfig(false)
+}
+
+func loadConfig(create bool) *Config {
+	viper.SetConfigFile(configFilePath())
+	viper.SetConfigType("toml")
+	viper.SetDefault("EnableDefaultJSManager", false)
+	viper.SetDefault("DefaultJSManager", "npm")
+	viper.SetDefault("AutoSelectClosest", true)
+	viper.SetDefault("RunInCurrentDir", false)
+	viper.SetDefault("ConfirmTasks", []string{})
+	viper.SetDefault("ManagerPriority", []string{})
+	viper.SetDefault("HiddenTasks", []string{})
+	viper.SetDefault("Aliases", map[string]string{})
+	viper.SetDefault("Shortcuts", map[string]any{})
+	viper.SetDefault("MatchWeights.Name", manager.DefaultMatchWeights.Name)
+	viper.SetDefault("MatchWeights.Alias", manager.DefaultMatchWeights.Alias)
+	viper.SetDefault("MatchWeights.Description", manager.DefaultMatchWeights.Description)
+
 	if err := viper.ReadInConfig(); err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
-			err := createConfig()
-			if err != nil {
-				logger.Error("Error creating config", err)
-				return nil
+		var pathErr *fs.PathError
+		if _, ok := err.(viper.ConfigFileNotFoundError); ok || errors.As(err, &pathErr) {
+			if create {
+				if err := createConfig(); err != nil {
+					logger.Error("Error creating config", err)
+					return nil
+				}
 			}
 		} else {
 			logger.Error("Error loading config", err)
@@ -29,21 +79,118 @@ func LoadConfig() *Config {
 
 	logger.Debug("Config loaded successfully")
 
-	defaultJSManager := validateDefaultJSManager(viper.GetString("DefaultJSManager"))
+	// Rules of the global and project configs are combined, so a project can't drop personal confirmations
+	confirmTasks := viper.GetStringSlice("ConfirmTasks")
+	hiddenTasks := viper.GetStringSlice("HiddenTasks")
 	enableDefaultJSManager := viper.GetBool("EnableDefaultJSManager")
-	autoSelectClosest := viper.GetBool("AutoSelectClosest")
 
-	if enableDefaultJSManager {
-		return &Config{
-			DefaultJSManager:  defaultJSManager,
-			AutoSelectClosest: autoSelectClosest,
+	if project := loadProjectConfig(); project != nil {
+		confirmTasks = append(confirmTasks, project.GetStringSlice("ConfirmTasks")...)
+		hiddenTasks = append(hiddenTasks, project.GetStringSlice("HiddenTasks")...)
+		// A project declaring its JS manager wants it to be used
+		enableDefaultJSManager = enableDefaultJSManager || project.IsSet("DefaultJSManager")
+
+		if err := viper.MergeConfigMap(project.AllSettings()); err != nil {
+			logger.Error("Error merging project config", err)
+			return nil
 		}
 	}
 
+	defaultJSManager := ""
+	if enableDefaultJSManager {
+		defaultJSManager = validateDefaultJSManager(viper.GetString("DefaultJSManager"))
+	}
+
 	return &Config{
-		DefaultJSManager:  "",
-		AutoSelectClosest: autoSelectClosest,
+		DefaultJSManager:  defaultJSManager,
+		AutoSelectClosest: viper.GetBool("AutoSelectClosest"),
+		RunInCurrentDir:   viper.GetBool("RunInCurrentDir"),
+		ConfirmTasks:      confirmTasks,
+		MatchWeights: manager.MatchWeights{
+			Name:        viper.GetFloat64("MatchWeights.Name"),
+			Alias:       viper.GetFloat64("MatchWeights.Alias"),
+			Description: viper.GetFloat64("MatchWeights.Description"),
+		},
+		ManagerPriority: viper.GetStringSlice("ManagerPriority"),
+		HiddenTasks:     hiddenTasks,
+		Aliases:         viper.GetStringMapString("Aliases"),
+		Shortcuts:       parseShortcuts(viper.GetStringMap("Shortcuts")),
+	}
+}
+
+// parseShortcuts accepts a single step or a list of steps for every shortcut
+func parseShortcuts(raw map[string]any) map[string][]string {
+	shortcuts := map[string][]string{}
+	for name, value := range raw {
+		if steps := toSteps(value); len(steps) > 0 {
+			shortcuts[name] = steps
+		} else {
+			logger.Warning("Invalid shortcut '" + name + "', expected a string or a list of strings")
+		}
+	}
+	return shortcuts
+}
+
+func toSteps(value any) []string {
+	switch value := value.(type) {
+	case string:
+		return []string{value}
+	case []any:
+		steps := []string{}
+		for _, step := range value {
+			s, ok := step.(string)
+			if !ok {
+				return nil
+			}
+			steps = append(steps, s)
+		}
+		return steps
+	case []string:
+		return value
 	}
+	return nil
+}
+
+// loadProjectConfig reads the closest project config between the current directory and the git root, if any
+func loadProjectConfig() *viper.Viper {
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil
+	}
+	filename := FindProjectConfig(dir, configfile.FindClosestGitDir(&dir))
+	if filename == "" {
+		return nil
+	}
+
+	project := viper.New()
+	project.SetConfigFile(filename)
+	project.SetConfigType("toml")
+	if err := project.ReadInConfig(); err != nil {
+		logger.Error("Error loading project config "+filename, err)
+		return nil
+	}
+
+	logger.Debug("Project config loaded from " + filename)
+	return project
+}
+
+// FindProjectConfig returns the closest project config from dir up to rootDir, or an empty string
+func FindProjectConfig(dir string, rootDir string) string {
+	for {
+		filename := filepath.Join(dir, ProjectConfigFilename)
+		if info, err := os.Stat(filename); err == nil && !info.IsDir() {
+			return filename
+		}
+		parentDir := filepath.Dir(dir)
+		if dir == rootDir || parentDir == dir {
+			return ""
+		}
+		dir = parentDir
+	}
+}
+
+func configFilePath() string {
+	return path.Join(os.Getenv("HOME"), ".rollercoaster", "config.toml")
 }
 
 func createConfig() error {
@@ -56,13 +203,6 @@ func createConfig() error {
 		}
 	}
 
-	viper.SetConfigFile(path.Join(os.Getenv("HOME"), ".rollercoaster", "config.toml"))
-	viper.SetConfigType("toml")
-
-	viper.SetDefault("EnableDefaultJSManager", false)
-	viper.SetDefault("DefaultJSManager", "npm")
-	viper.SetDefault("AutoSelectClosest", true)
-
 	err := viper.WriteConfig()
 	if err != nil {
 		return err
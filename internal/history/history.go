@@ -0,0 +1,195 @@
+package history
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+)
+
+// maxEntries limits the history file, older entries are dropped first
+const maxEntries = 5000
+
+type Entry struct {
+	RepoRoot string        `json:"repo"`
+	Dir      string        `json:"dir,omitempty"`
+	Manager  string        `json:"manager"`
+	Source   string        `json:"source,omitempty"` // file defining the task, tells apart managers sharing a title
+	Task     string        `json:"task"`
+	Args     []string      `json:"args,omitempty"`
+	Time     time.Time     `json:"time"`
+	Duration time.Duration `json:"duration"`
+	ExitCode int           `json:"exit_code"`
+}
+
+// SourceOf returns the file defining the task, or its directory when the file is unknown
+func SourceOf(managerTask manager.ManagerTask) string {
+	source := manager.GetSource(*managerTask.Manager)
+	if source.Filename != "" {
+		return source.Filename
+	}
+	return source.Dir
+}
+
+// Matches reports whether the entry is a run of the task. Entries are matched by the file defining the task,
+// older entries and tasks of managers without a file are matched by the manager name.
+func (e Entry) Matches(managerTask manager.ManagerTask) bool {
+	if e.Task != managerTask.Name {
+		return false
+	}
+	if source := SourceOf(managerTask); e.Source != "" && source != "" {
+		return e.Source == source
+	}
+	return e.Manager == (*managerTask.Manager).GetTitle().Name
+}
+
+type History struct {
+	filename string
+	entries  []Entry
+}
+
+func DefaultFilename() string {
+	return path.Join(os.Getenv("HOME"), ".rollercoaster", "history.jsonl")
+}
+
+// Load reads the history file, a missing file results in an empty history
+func Load(filename string) (*History, error) {
+	h := &History{filename: filename}
+
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry Entry
+		// Skip corrupted lines instead of losing the whole history
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		h.entries = append(h.entries, entry)
+	}
+	return h, scanner.Err()
+}
+
+// Entries returns all entries from the oldest to the newest
+func (h *History) Entries() []Entry {
+	return h.entries
+}
+
+// Recent returns the entries executed in the repository from the newest to the oldest
+func (h *History) Recent(repoRoot string) []Entry {
+	entries := []Entry{}
+	for i := len(h.entries) - 1; i >= 0; i-- {
+		if h.entries[i].RepoRoot == repoRoot {
+			entries = append(entries, h.entries[i])
+		}
+	}
+	return entries
+}
+
+// Append adds the entry to the history and persists it
+func (h *History) Append(entry Entry) error {
+	h.entries = append(h.entries, entry)
+
+	if err := os.MkdirAll(filepath.Dir(h.filename), 0755); err != nil {
+		return err
+	}
+
+	if len(h.entries) > maxEntries {
+		h.entries = h.entries[len(h.entries)-maxEntries:]
+		return h.write()
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(h.filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
+
+func (h *History) write() error {
+	var buf bytes.Buffer
+	for _, entry := range h.entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		buf.Write(append(line, '\n'))
+	}
+	return os.WriteFile(h.filename, buf.Bytes(), 0644)
+}
+
+type taskKey struct {
+	source  string
+	manager string
+	task    string
+}
+
+// frecency returns the frecency of every task executed in the repository, counting each run
+// with a weight decreasing with its age
+func (h *History) frecency(repoRoot string, now time.Time) map[taskKey]float64 {
+	frecency := map[taskKey]float64{}
+	for _, entry := range h.entries {
+		if entry.RepoRoot != repoRoot {
+			continue
+		}
+		// Runs with a source are counted by it only, so a new manager version keeps the history
+		key := taskKey{source: entry.Source, task: entry.Task}
+		if entry.Source == "" {
+			key.manager = entry.Manager
+		}
+		frecency[key] += recencyWeight(now.Sub(entry.Time))
+	}
+	return frecency
+}
+
+func recencyWeight(age time.Duration) float64 {
+	switch {
+	case age < 4*time.Hour:
+		return 100
+	case age < 24*time.Hour:
+		return 80
+	case age < 7*24*time.Hour:
+		return 60
+	case age < 30*24*time.Hour:
+		return 30
+	case age < 90*24*time.Hour:
+		return 10
+	default:
+		return 1
+	}
+}
+
+// Scorer converts the frecency of tasks in the repository into a bonus for the fuzzy match score.
+// The bonus grows logarithmically so a single run doesn't outweigh a much better match.
+func (h *History) Scorer(repoRoot string, now time.Time) manager.TaskScorer {
+	frecency := h.frecency(repoRoot, now)
+	return func(managerTask manager.ManagerTask) int {
+		runs := frecency[taskKey{manager: (*managerTask.Manager).GetTitle().Name, task: managerTask.Name}]
+		if source := SourceOf(managerTask); source != "" {
+			runs += frecency[taskKey{source: source, task: managerTask.Name}]
+		}
+		return int(10 * math.Log2(1+runs/100))
+	}
+}
@@ -0,0 +1,178 @@
+package history_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/history"
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockManager struct {
+	name string
+}
+
+func (m *mockManager) GetTitle() manager.Title                           { return manager.Title{Name: m.name} }
+func (m *mockManager) ListTasks() ([]task.Task, error)                   { return nil, nil }
+func (m *mockManager) ExecuteTask(task *task.Task, args ...string) error { return nil }
+
+type mockSourceManager struct {
+	mockManager
+	filename string
+}
+
+func (m *mockSourceManager) GetSource() manager.Source { return manager.Source{Filename: m.filename} }
+
+func managerTask(managerName, taskName string) manager.ManagerTask {
+	var m manager.Manager = &mockManager{name: managerName}
+	return manager.ManagerTask{Task: task.Task{Name: taskName}, Manager: &m}
+}
+
+func sourceTask(managerName, filename, taskName string) manager.ManagerTask {
+	var m manager.Manager = &mockSourceManager{mockManager: mockManager{name: managerName}, filename: filename}
+	return manager.ManagerTask{Task: task.Task{Name: taskName}, Manager: &m}
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	h, err := history.Load(filepath.Join(t.TempDir(), "history.jsonl"))
+	require.NoError(t, err, "Load() should not return error for missing file")
+	assert.Empty(t, h.Entries(), "History should be empty")
+}
+
+func TestAppend_PersistsEntries(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "nested", "history.jsonl")
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	h, err := history.Load(filename)
+	require.NoError(t, err, "Load() should not return error")
+
+	entries := []history.Entry{
+		{RepoRoot: "/repo", Manager: "npm", Task: "test", Args: []string{"--watch"}, Time: now, Duration: time.Second, ExitCode: 1},
+		{RepoRoot: "/repo", Manager: "task", Task: "build", Time: now.Add(time.Minute)},
+	}
+	for _, entry := range entries {
+		require.NoError(t, h.Append(entry), "Append() should not return error")
+	}
+
+	reloaded, err := history.Load(filename)
+	require.NoError(t, err, "Load() should not return error")
+	assert.Equal(t, entries, reloaded.Entries(), "Entries should survive a reload")
+}
+
+func TestLoad_SkipsCorruptedLines(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "history.jsonl")
+	content := `{"repo":"/repo","manager":"npm","task":"test","time":"2025-01-02T03:04:05Z","duration":0,"exit_code":0}
+not json
+`
+	require.NoError(t, os.WriteFile(filename, []byte(content), 0644))
+
+	h, err := history.Load(filename)
+	require.NoError(t, err, "Load() should not return error")
+	require.Len(t, h.Entries(), 1, "Corrupted lines should be skipped")
+	assert.Equal(t, "test", h.Entries()[0].Task)
+}
+
+func TestScorer(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	h, err := history.Load(filepath.Join(t.TempDir(), "history.jsonl"))
+	require.NoError(t, err)
+
+	for i := 0; i < 50; i++ {
+		require.NoError(t, h.Append(history.Entry{RepoRoot: "/repo", Manager: "npm", Task: "test", Time: now.Add(-time.Hour)}))
+	}
+	require.NoError(t, h.Append(history.Entry{RepoRoot: "/repo", Manager: "npm", Task: "lint", Time: now.Add(-time.Hour)}))
+	require.NoError(t, h.Append(history.Entry{RepoRoot: "/repo", Manager: "npm", Task: "build", Time: now.Add(-200 * 24 * time.Hour)}))
+	require.NoError(t, h.Append(history.Entry{RepoRoot: "/other", Manager: "npm", Task: "deploy", Time: now}))
+
+	scorer := h.Scorer("/repo", now)
+
+	frequent := scorer(managerTask("npm", "test"))
+	once := scorer(managerTask("npm", "lint"))
+	old := scorer(managerTask("npm", "build"))
+
+	assert.Greater(t, frequent, once, "Frequently run task should score higher")
+	assert.Greater(t, once, old, "Recently run task should score higher than an old one")
+	assert.Zero(t, scorer(managerTask("npm", "deploy")), "Runs from other repositories should not count")
+	assert.Zero(t, scorer(managerTask("task", "test")), "Runs of another manager should not count")
+	assert.Zero(t, scorer(managerTask("npm", "never-run")), "Never run task should not get a bonus")
+}
+
+func TestScorer_Source(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	h, err := history.Load(filepath.Join(t.TempDir(), "history.jsonl"))
+	require.NoError(t, err)
+
+	require.NoError(t, h.Append(history.Entry{RepoRoot: "/repo", Manager: "pnpm@9", Source: "/repo/web/package.json", Task: "dev", Time: now}))
+
+	scorer := h.Scorer("/repo", now)
+	assert.Positive(t, scorer(sourceTask("pnpm@10+", "/repo/web/package.json", "dev")), "Runs should count after a manager version change")
+	assert.Zero(t, scorer(sourceTask("pnpm@9", "/repo/api/package.json", "dev")), "Runs of another package should not count")
+}
+
+func TestEntry_Matches(t *testing.T) {
+	tests := []struct {
+		name        string
+		entry       history.Entry
+		managerTask manager.ManagerTask
+		expect      bool
+	}{
+		{
+			name:        "same source",
+			entry:       history.Entry{Manager: "pnpm@9", Source: "/repo/web/package.json", Task: "dev"},
+			managerTask: sourceTask("pnpm@10+", "/repo/web/package.json", "dev"),
+			expect:      true,
+		},
+		{
+			name:        "other package with the same manager",
+			entry:       history.Entry{Manager: "pnpm@9", Source: "/repo/web/package.json", Task: "dev"},
+			managerTask: sourceTask("pnpm@9", "/repo/api/package.json", "dev"),
+			expect:      false,
+		},
+		{
+			name:        "other task",
+			entry:       history.Entry{Manager: "pnpm@9", Source: "/repo/web/package.json", Task: "dev"},
+			managerTask: sourceTask("pnpm@9", "/repo/web/package.json", "build"),
+			expect:      false,
+		},
+		{
+			name:        "entry without source",
+			entry:       history.Entry{Manager: "pnpm@9", Task: "dev"},
+			managerTask: sourceTask("pnpm@9", "/repo/api/package.json", "dev"),
+			expect:      true,
+		},
+		{
+			name:        "manager without source",
+			entry:       history.Entry{Manager: "config", Source: "", Task: "ci"},
+			managerTask: managerTask("config", "ci"),
+			expect:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, tt.entry.Matches(tt.managerTask))
+		})
+	}
+}
+
+func TestRecent(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	h, err := history.Load(filepath.Join(t.TempDir(), "history.jsonl"))
+	require.NoError(t, err)
+
+	require.NoError(t, h.Append(history.Entry{RepoRoot: "/repo", Manager: "npm", Task: "build", Time: now}))
+	require.NoError(t, h.Append(history.Entry{RepoRoot: "/other", Manager: "npm", Task: "lint", Time: now.Add(time.Minute)}))
+	require.NoError(t, h.Append(history.Entry{RepoRoot: "/repo", Manager: "npm", Task: "test", Time: now.Add(2 * time.Minute)}))
+
+	recent := h.Recent("/repo")
+	require.Len(t, recent, 2, "Only entries of the repository should be returned")
+	assert.Equal(t, "test", recent[0].Task, "Newest entry should be first")
+	assert.Equal(t, "build", recent[1].Task, "Oldest entry should be last")
+
+	assert.Empty(t, h.Recent("/unknown"), "Unknown repository should have no entries")
+}
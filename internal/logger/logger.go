@@ -9,6 +9,9 @@ import (
 
 var MODE = "PROD"
 
+// QUIET suppresses info, warning and debug messages, e.g. while generating shell completions
+var QUIET = false
+
 var (
 	errStyle = lipgloss.NewStyle().
 			Background(lipgloss.Color("#fe5069")).
@@ -59,14 +62,23 @@ func Fatal(err error) {
 }
 
 func Info(message string) {
+	if QUIET {
+		return
+	}
 	_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", infoMessageChip, message)
 }
 
 func Warning(message string) {
+	if QUIET {
+		return
+	}
 	_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", warnMessageChip, message)
 }
 
 func Debug(message ...any) {
+	if QUIET {
+		return
+	}
 	if MODE == "DEV" || MODE == "TEST" {
 		_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", debugMessageChip, message)
 	}
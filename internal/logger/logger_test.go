@@ -169,6 +169,27 @@ func TestInfo(t *testing.T) {
 	}
 }
 
+func TestQuiet(t *testing.T) {
+	logger.QUIET = true
+	defer func() { logger.QUIET = false }()
+
+	// Capture stdout
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	logger.Info("test info message")
+	logger.Warning("test warning message")
+	logger.Debug("test debug message")
+
+	_ = w.Close()
+	os.Stdout = oldStdout
+
+	var buf bytes.Buffer
+	_, _ = buf.ReadFrom(r)
+	assert.Empty(t, buf.String(), "Info, warning and debug messages should be suppressed in quiet mode")
+}
+
 func TestWarning(t *testing.T) {
 	tests := []struct {
 		name     string
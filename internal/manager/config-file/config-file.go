@@ -1,6 +1,7 @@
 package configfile
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -40,7 +41,7 @@ func (c *ParseConfig) GetDirectories() []string {
 	parsedDirectories = append(parsedDirectories, rootDir)
 	slices.Reverse(parsedDirectories)
 
-	fmt.Println(parsedDirectories)
+	logger.Debug(fmt.Sprintf("Parsed directories: %v", parsedDirectories))
 
 	return parsedDirectories
 }
@@ -72,6 +73,63 @@ func ParseFileAsJson[T any](mf *ConfigFile) (T, error) {
 	return result, nil
 }
 
+// ParseFileAsJsonc parses JSON with comments and trailing commas (deno.jsonc, tsconfig.json style)
+func ParseFileAsJsonc[T any](mf *ConfigFile) (T, error) {
+	return ParseFileAsJson[T](&ConfigFile{
+		Filename: mf.Filename,
+		File:     stripJsonComments(mf.File),
+	})
+}
+
+func stripJsonComments(data []byte) []byte {
+	result := make([]byte, 0, len(data))
+	inString := false
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+
+		if inString {
+			result = append(result, c)
+			if c == '\\' && i+1 < len(data) {
+				i++
+				result = append(result, data[i])
+			} else if c == '"' {
+				inString = false
+			}
+			continue
+		}
+
+		switch {
+		case c == '"':
+			inString = true
+			result = append(result, c)
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			if i < len(data) {
+				result = append(result, '\n')
+			}
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			i += 2
+			for i+1 < len(data) && (data[i] != '*' || data[i+1] != '/') {
+				i++
+			}
+			i++
+		case c == ']' || c == '}':
+			// Drop trailing comma before closing bracket
+			trimmed := bytes.TrimRight(result, " \t\r\n")
+			if len(trimmed) > 0 && trimmed[len(trimmed)-1] == ',' {
+				result = append(trimmed[:len(trimmed)-1], result[len(trimmed):]...)
+			}
+			result = append(result, c)
+		default:
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
 func FindFirstInDirectory(dir *string, filenames []string) *ConfigFile {
 	for _, filename := range filenames {
 		file := FindInDirectory(dir, filename)
@@ -109,3 +167,24 @@ func FindInDirectory(dir *string, filename string) *ConfigFile {
 		File:     file,
 	}
 }
+
+// FindClosestGitDir returns the closest parent directory containing .git, or dir itself when there is none
+func FindClosestGitDir(dir *string) string {
+	if dir == nil || *dir == "" {
+		return ""
+	}
+	currentDir := *dir
+	for {
+		gitPath := filepath.Join(currentDir, ".git")
+		info, err := os.Stat(gitPath)
+		if err == nil && info.IsDir() {
+			return currentDir
+		}
+		parentDir := filepath.Dir(currentDir)
+		if parentDir == currentDir {
+			break
+		}
+		currentDir = parentDir
+	}
+	return *dir
+}
@@ -1,6 +1,7 @@
 package configfile_test
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -87,6 +88,60 @@ func TestParseFileAsJson(t *testing.T) {
 	}
 }
 
+func TestParseFileAsJsonc(t *testing.T) {
+	tests := []struct {
+		name        string
+		filename    string
+		expectError bool
+		expected    PackageJSON
+	}{
+		{
+			name:        "jsonc with comments and trailing commas",
+			filename:    "commented.jsonc",
+			expectError: false,
+			expected: PackageJSON{
+				Name:        "jsonc-project",
+				Version:     "0.3.0",
+				Description: "Has // slashes and /* stars */ inside a string",
+				Scripts:     map[string]string{"dev": "deno run --watch main.ts"},
+			},
+		},
+		{
+			name:        "plain json",
+			filename:    "minimal-package.json",
+			expectError: false,
+			expected: PackageJSON{
+				Name:    "minimal-project",
+				Version: "0.1.0",
+			},
+		},
+		{
+			name:        "invalid JSON",
+			filename:    "invalid.json",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mf := config.FindInDirectory(&testdataDir, tt.filename)
+			require.NotNil(t, mf, "Failed to find test file: %s", tt.filename)
+
+			result, err := config.ParseFileAsJsonc[PackageJSON](mf)
+
+			if tt.expectError {
+				assert.Error(t, err, "Expected error but got none")
+			} else {
+				assert.NoError(t, err, "Unexpected error")
+				assert.Equal(t, tt.expected.Name, result.Name, "Name should match expected value")
+				assert.Equal(t, tt.expected.Version, result.Version, "Version should match expected value")
+				assert.Equal(t, tt.expected.Description, result.Description, "Description should match expected value")
+				assert.Equal(t, tt.expected.Scripts, result.Scripts, "Scripts should match expected value")
+			}
+		})
+	}
+}
+
 func TestParseFileAsYaml(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -338,3 +393,19 @@ func TestManagerParseConfig_GetDirectories_DeepNesting(t *testing.T) {
 	assert.Equal(t, "/user/projects", result[0], "First directory should be root directory")
 	assert.Equal(t, "/user/projects/myapp/src/components/ui", result[len(result)-1], "Last directory should be current directory")
 }
+
+func TestFindClosestGitDir(t *testing.T) {
+	root := t.TempDir()
+	nested := filepath.Join(root, "packages", "web")
+	require.NoError(t, os.MkdirAll(nested, 0755))
+
+	assert.Equal(t, nested, config.FindClosestGitDir(&nested), "Directory itself should be returned without .git")
+
+	require.NoError(t, os.Mkdir(filepath.Join(root, ".git"), 0755))
+	assert.Equal(t, root, config.FindClosestGitDir(&nested), "Closest parent with .git should be returned")
+	assert.Equal(t, root, config.FindClosestGitDir(&root), "Directory with .git should be returned")
+
+	empty := ""
+	assert.Equal(t, "", config.FindClosestGitDir(&empty), "Empty directory should stay empty")
+	assert.Equal(t, "", config.FindClosestGitDir(nil), "Nil directory should be empty")
+}
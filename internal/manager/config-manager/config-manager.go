@@ -0,0 +1,89 @@
+package configmanager
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+)
+
+// ConfigManager provides the shortcuts declared in the config as tasks.
+// Every step of a shortcut is either the exact name or alias of a task of Managers or a shell command.
+type ConfigManager struct {
+	Shortcuts map[string][]string
+	// Managers resolve the steps referencing tasks
+	Managers []manager.Manager
+	// Options are used to match the steps against the tasks of Managers
+	Options *manager.Options
+	// ExecuteOptions are used to run every step
+	ExecuteOptions *manager.ExecuteOptions
+}
+
+func (m *ConfigManager) ListTasks() ([]task.Task, error) {
+	tasks := make([]task.Task, 0, len(m.Shortcuts))
+	for name, steps := range m.Shortcuts {
+		tasks = append(tasks, task.Task{
+			Name:        name,
+			Description: strings.Join(steps, " && "),
+		})
+	}
+	task.SortTasks(tasks)
+	return tasks, nil
+}
+
+// ExecuteTask runs the steps of the shortcut one by one and stops on the first failure, args are passed to the last step
+func (m *ConfigManager) ExecuteTask(task *task.Task, args ...string) error {
+	steps, ok := m.Shortcuts[task.Name]
+	if !ok {
+		return fmt.Errorf("unknown shortcut '%s'", task.Name)
+	}
+
+	for i, step := range steps {
+		var stepArgs []string
+		if i == len(steps)-1 {
+			stepArgs = args
+		}
+		if err := m.executeStep(step, stepArgs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *ConfigManager) executeStep(step string, args []string) error {
+	stepTask, err := m.findTask(step)
+	if err != nil {
+		return err
+	}
+	if stepTask != nil {
+		return manager.Execute(stepTask, m.ExecuteOptions, args...)
+	}
+
+	// Arguments are passed to the command as positional parameters
+	cmd := exec.Command("sh", "-c", step+` "$@"`, "sh")
+	return manager.CommandExecute(cmd, m.ExecuteOptions, args...)
+}
+
+// findTask returns the task having step as its exact name or alias, or nil when the step is a shell command
+func (m *ConfigManager) findTask(step string) (*manager.ManagerTask, error) {
+	found, err := manager.FindClosestTaskFromList(m.Managers, step, m.Options)
+	var ambiguousErr *manager.AmbiguousMatchError
+	if errors.As(err, &ambiguousErr) {
+		return nil, fmt.Errorf("shortcut step '%s' matches tasks of several managers, set managerpriority in the config", step)
+	}
+	if err != nil || (found.Name != step && !slices.Contains(found.Aliases, step)) {
+		return nil, nil
+	}
+	return found, nil
+}
+
+func (m *ConfigManager) GetTitle() manager.Title {
+	return manager.Title{
+		Name:        "config",
+		Description: "shortcuts from the config",
+	}
+}
@@ -0,0 +1,99 @@
+package configmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	configmanager "github.com/dmitriy-rs/rollercoaster/internal/manager/config-manager"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type executedTask struct {
+	name string
+	args []string
+}
+
+type mockManager struct {
+	name     string
+	tasks    []task.Task
+	executed *[]executedTask
+}
+
+func (m *mockManager) GetTitle() manager.Title         { return manager.Title{Name: m.name} }
+func (m *mockManager) ListTasks() ([]task.Task, error) { return m.tasks, nil }
+func (m *mockManager) ExecuteTask(task *task.Task, args ...string) error {
+	*m.executed = append(*m.executed, executedTask{name: task.Name, args: args})
+	return nil
+}
+
+func TestConfigManager_ListTasks(t *testing.T) {
+	configManager := &configmanager.ConfigManager{
+		Shortcuts: map[string][]string{
+			"up": {"docker compose up -d"},
+			"ci": {"lint", "test"},
+		},
+	}
+
+	tasks, err := configManager.ListTasks()
+	require.NoError(t, err)
+	assert.Equal(t, []task.Task{
+		{Name: "ci", Description: "lint && test"},
+		{Name: "up", Description: "docker compose up -d"},
+	}, tasks)
+	assert.Equal(t, "config", configManager.GetTitle().Name)
+}
+
+func TestConfigManager_ExecuteTask(t *testing.T) {
+	executed := []executedTask{}
+	taskfile := &mockManager{
+		name: "task",
+		tasks: []task.Task{
+			{Name: "lint"},
+			{Name: "test:unit", Aliases: []string{"tu"}},
+		},
+		executed: &executed,
+	}
+	marker := filepath.Join(t.TempDir(), "marker")
+
+	configManager := &configmanager.ConfigManager{
+		Shortcuts: map[string][]string{
+			"ci":    {"lint", "tu"},
+			"touch": {"touch"},
+			"fail":  {"lint", "exit 3", "tu"},
+		},
+		Managers: []manager.Manager{taskfile},
+	}
+
+	t.Run("tasks referenced by name and alias", func(t *testing.T) {
+		executed = executed[:0]
+		err := configManager.ExecuteTask(&task.Task{Name: "ci"}, "--verbose")
+		require.NoError(t, err)
+		assert.Equal(t, []executedTask{
+			{name: "lint"},
+			{name: "test:unit", args: []string{"--verbose"}},
+		}, executed, "Arguments should be passed to the last step")
+	})
+
+	t.Run("shell command", func(t *testing.T) {
+		err := configManager.ExecuteTask(&task.Task{Name: "touch"}, marker)
+		require.NoError(t, err)
+		assert.FileExists(t, marker, "Arguments should be passed to the command")
+	})
+
+	t.Run("stops on the first failure", func(t *testing.T) {
+		executed = executed[:0]
+		err := configManager.ExecuteTask(&task.Task{Name: "fail"})
+		var exitErr *manager.ExitError
+		require.ErrorAs(t, err, &exitErr)
+		assert.Equal(t, 3, exitErr.Code)
+		assert.Equal(t, []executedTask{{name: "lint"}}, executed, "Steps after the failure should not run")
+	})
+
+	t.Run("unknown shortcut", func(t *testing.T) {
+		err := configManager.ExecuteTask(&task.Task{Name: "missing"})
+		assert.Error(t, err)
+	})
+}
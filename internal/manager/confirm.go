@@ -0,0 +1,26 @@
+package manager
+
+import (
+	"path"
+	"slices"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+)
+
+// NeedsConfirmation reports whether a task reached by query has to be confirmed before execution.
+// Tasks with prompts or matching one of the glob patterns (e.g. "deploy*", "*:prod") are guarded
+// unless query is exactly the task name or one of its aliases.
+func NeedsConfirmation(t task.Task, query string, patterns []string) bool {
+	if query == t.Name || slices.Contains(t.Aliases, query) {
+		return false
+	}
+	if len(t.Prompts) > 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, t.Name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
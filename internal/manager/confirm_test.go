@@ -0,0 +1,70 @@
+package manager_test
+
+import (
+	"testing"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNeedsConfirmation(t *testing.T) {
+	patterns := []string{"deploy*", "*:prod", "db:drop"}
+
+	tests := []struct {
+		name     string
+		task     task.Task
+		query    string
+		expected bool
+	}{
+		{
+			name:     "fuzzy match of guarded prefix pattern",
+			task:     task.Task{Name: "deploy"},
+			query:    "dep",
+			expected: true,
+		},
+		{
+			name:     "fuzzy match of guarded suffix pattern",
+			task:     task.Task{Name: "release:prod"},
+			query:    "relp",
+			expected: true,
+		},
+		{
+			name:     "fuzzy match of guarded exact pattern",
+			task:     task.Task{Name: "db:drop"},
+			query:    "drop",
+			expected: true,
+		},
+		{
+			name:     "exact task name is not guarded",
+			task:     task.Task{Name: "deploy"},
+			query:    "deploy",
+			expected: false,
+		},
+		{
+			name:     "exact alias is not guarded",
+			task:     task.Task{Name: "deploy", Aliases: []string{"d"}},
+			query:    "d",
+			expected: false,
+		},
+		{
+			name:     "task with prompt reached by fuzzy match",
+			task:     task.Task{Name: "reset", Prompts: []string{"Are you sure?"}},
+			query:    "res",
+			expected: true,
+		},
+		{
+			name:     "task not matching any pattern",
+			task:     task.Task{Name: "build"},
+			query:    "bld",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.NeedsConfirmation(tt.task, tt.query, patterns)
+			assert.Equal(t, tt.expected, result, "NeedsConfirmation() should match for %s reached by %q", tt.task.Name, tt.query)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package manager
+
+import (
+	"path"
+	"slices"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+)
+
+// customizeTasks drops the hidden tasks and adds the aliases of the options
+func customizeTasks(tasks []task.Task, options *Options) []task.Task {
+	hiddenTasks := options.hiddenTasks()
+	taskAliases := options.aliases()
+	if len(hiddenTasks) == 0 && len(taskAliases) == 0 {
+		return tasks
+	}
+
+	aliases := make([]string, 0, len(taskAliases))
+	for alias := range taskAliases {
+		aliases = append(aliases, alias)
+	}
+	slices.Sort(aliases)
+
+	customized := []task.Task{}
+	for _, t := range tasks {
+		if isHidden(t.Name, hiddenTasks) {
+			continue
+		}
+		for _, alias := range aliases {
+			if taskAliases[alias] == t.Name && !slices.Contains(t.Aliases, alias) {
+				// Clone to not modify the tasks of the manager
+				t.Aliases = append(slices.Clone(t.Aliases), alias)
+			}
+		}
+		customized = append(customized, t)
+	}
+	return customized
+}
+
+func isHidden(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
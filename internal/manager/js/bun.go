@@ -0,0 +1,66 @@
+package jsmanager
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+type BunWorkspace struct {
+	pinnedVersion string
+}
+
+// bun.lock is the text lockfile used since bun 1.2, bun.lockb is the older binary one
+var bunLockFilenames = [2]string{
+	"bun.lock",
+	"bun.lockb",
+}
+
+func ParseBunWorkspace(dir *string) (*BunWorkspace, error) {
+	for _, filename := range bunLockFilenames {
+		bunLockFile, err := os.Stat(filepath.Join(*dir, filename))
+		if err == nil && !bunLockFile.IsDir() {
+			return &BunWorkspace{}, nil
+		}
+	}
+	return nil, nil
+}
+
+func GetDefaultBunWorkspace() BunWorkspace {
+	return BunWorkspace{}
+}
+
+func (m *BunWorkspace) Name() string {
+	if m.pinnedVersion != "" {
+		return "bun@" + m.pinnedVersion
+	}
+	return "bun"
+}
+
+func (m *BunWorkspace) ExecName() string {
+	return "bunx"
+}
+
+func (m *BunWorkspace) Cmd() *exec.Cmd {
+	return exec.Command("bun", "run")
+}
+
+func (m *BunWorkspace) InstallCmd() *exec.Cmd {
+	return exec.Command("bun", "install")
+}
+
+func (m *BunWorkspace) ExecuteCmd() *exec.Cmd {
+	return exec.Command("bunx")
+}
+
+func (m *BunWorkspace) AddCmd() *exec.Cmd {
+	return exec.Command("bun", "add")
+}
+
+func (m *BunWorkspace) RemoveCmd() *exec.Cmd {
+	return exec.Command("bun", "remove")
+}
+
+func (m *BunWorkspace) FilterCmd(packageName string) *exec.Cmd {
+	return exec.Command("bun", "run", "--filter", packageName)
+}
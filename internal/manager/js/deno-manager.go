@@ -0,0 +1,103 @@
+package jsmanager
+
+import (
+	"encoding/json"
+	"os/exec"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	config "github.com/dmitriy-rs/rollercoaster/internal/manager/config-file"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+)
+
+type DenoManager struct {
+	config   denoJsonConfig
+	filename string
+	dir      string
+}
+
+type denoJsonConfig struct {
+	Tasks map[string]denoTask `json:"tasks"`
+}
+
+// denoTask is either a plain command string or an object with a description
+type denoTask struct {
+	Command     string `json:"command"`
+	Description string `json:"description"`
+}
+
+func (t *denoTask) UnmarshalJSON(data []byte) error {
+	var command string
+	if err := json.Unmarshal(data, &command); err == nil {
+		t.Command = command
+		return nil
+	}
+
+	type denoTaskObject denoTask
+	var object denoTaskObject
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+	*t = denoTask(object)
+	return nil
+}
+
+var denoJsonFilenames = [2]string{
+	"deno.json",
+	"deno.jsonc",
+}
+
+func ParseDenoManager(dir *string) (*DenoManager, error) {
+	denoJsonFile := config.FindFirstInDirectory(dir, denoJsonFilenames[:])
+	if denoJsonFile == nil {
+		return nil, nil
+	}
+	config, err := config.ParseFileAsJsonc[denoJsonConfig](denoJsonFile)
+	if err != nil {
+		return nil, err
+	}
+	return &DenoManager{
+		config:   config,
+		filename: denoJsonFile.Filename,
+		dir:      *dir,
+	}, nil
+}
+
+func (m *DenoManager) ListTasks() ([]task.Task, error) {
+	tasks := []task.Task{}
+	for name, denoTask := range m.config.Tasks {
+		description := denoTask.Description
+		if description == "" {
+			description = denoTask.Command
+		}
+		tasks = append(tasks, task.Task{
+			Name:        name,
+			Description: description,
+		})
+	}
+	task.SortTasks(tasks)
+	return tasks, nil
+}
+
+func (m *DenoManager) ExecuteTask(task *task.Task, args ...string) error {
+	return manager.CommandExecute(m.TaskCommand(task), nil, args...)
+}
+
+func (m *DenoManager) TaskCommand(task *task.Task) *exec.Cmd {
+	cmd := exec.Command("deno", "task", task.Name)
+	cmd.Dir = m.dir
+	return cmd
+}
+
+func (m *DenoManager) GetTitle() manager.Title {
+	return manager.Title{
+		Name:        "deno",
+		Description: "parsed from " + m.filename,
+	}
+}
+
+func (m *DenoManager) GetSource() manager.Source {
+	return manager.Source{
+		Filename: m.filename,
+		Dir:      m.dir,
+	}
+}
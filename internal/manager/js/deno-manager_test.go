@@ -0,0 +1,68 @@
+package jsmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	jsmanager "github.com/dmitriy-rs/rollercoaster/internal/manager/js"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDenoManager(t *testing.T) {
+	tests := []struct {
+		name         string
+		testdataDir  string
+		wantNil      bool
+		wantFilename string
+		wantTasks    []task.Task
+	}{
+		{
+			name:         "deno.json with string and object tasks",
+			testdataDir:  "deno-with-lock",
+			wantFilename: "deno.json",
+			wantTasks: []task.Task{
+				{Name: "build", Description: "deno compile main.ts"},
+				{Name: "dev", Description: "deno run --watch main.ts"},
+				{Name: "test", Description: "Run the test suite"},
+			},
+		},
+		{
+			name:         "deno.jsonc with comments",
+			testdataDir:  "deno-jsonc",
+			wantFilename: "deno.jsonc",
+			wantTasks: []task.Task{
+				{Name: "serve", Description: "Serve the edge function"},
+			},
+		},
+		{
+			name:        "package.json only",
+			testdataDir: "with-scripts",
+			wantNil:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := filepath.Join("testdata", tt.testdataDir)
+
+			manager, err := jsmanager.ParseDenoManager(&testDir)
+			require.NoError(t, err, "ParseDenoManager() should not return error for %s", tt.testdataDir)
+
+			if tt.wantNil {
+				assert.Nil(t, manager, "ParseDenoManager() should return nil for %s", tt.testdataDir)
+				return
+			}
+			require.NotNil(t, manager, "ParseDenoManager() should return manager for %s", tt.testdataDir)
+
+			tasks, err := manager.ListTasks()
+			require.NoError(t, err, "ListTasks() should not return error")
+			assert.Equal(t, tt.wantTasks, tasks, "Should list deno tasks sorted by name")
+
+			title := manager.GetTitle()
+			assert.Equal(t, "deno", title.Name, "Title name should be 'deno'")
+			assert.Contains(t, title.Description, tt.wantFilename, "Title should mention the parsed file")
+		})
+	}
+}
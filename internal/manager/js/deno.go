@@ -0,0 +1,52 @@
+package jsmanager
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+type DenoWorkspace struct {
+}
+
+const denoLockFilename = "deno.lock"
+
+func ParseDenoWorkspace(dir *string) (*DenoWorkspace, error) {
+	denoLockFile, err := os.Stat(filepath.Join(*dir, denoLockFilename))
+	if err != nil || denoLockFile.IsDir() {
+		return nil, nil
+	}
+	return &DenoWorkspace{}, nil
+}
+
+func GetDefaultDenoWorkspace() DenoWorkspace {
+	return DenoWorkspace{}
+}
+
+func (m *DenoWorkspace) Name() string {
+	return "deno"
+}
+
+func (m *DenoWorkspace) ExecName() string {
+	return "deno run"
+}
+
+func (m *DenoWorkspace) Cmd() *exec.Cmd {
+	return exec.Command("deno", "task")
+}
+
+func (m *DenoWorkspace) InstallCmd() *exec.Cmd {
+	return exec.Command("deno", "install")
+}
+
+func (m *DenoWorkspace) ExecuteCmd() *exec.Cmd {
+	return exec.Command("deno", "run")
+}
+
+func (m *DenoWorkspace) AddCmd() *exec.Cmd {
+	return exec.Command("deno", "add")
+}
+
+func (m *DenoWorkspace) RemoveCmd() *exec.Cmd {
+	return exec.Command("deno", "remove")
+}
@@ -0,0 +1,102 @@
+package jsmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	jsmanager "github.com/dmitriy-rs/rollercoaster/internal/manager/js"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDenoWorkspace(t *testing.T) {
+	tests := []struct {
+		name        string
+		testdataDir string
+		wantNil     bool
+		wantError   bool
+	}{
+		{
+			name:        "deno with lock",
+			testdataDir: "deno-with-lock",
+			wantNil:     false,
+			wantError:   false,
+		},
+		{
+			name:        "empty directory (no deno.lock)",
+			testdataDir: "empty",
+			wantNil:     true,
+			wantError:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := filepath.Join("testdata", tt.testdataDir)
+			workspace, err := jsmanager.ParseDenoWorkspace(&testDir)
+
+			if tt.wantError {
+				assert.Error(t, err, "ParseDenoWorkspace() should return error for %s", tt.testdataDir)
+				assert.Nil(t, workspace, "ParseDenoWorkspace() should return nil workspace when error occurs")
+				return
+			}
+
+			assert.NoError(t, err, "ParseDenoWorkspace() should not return error for %s", tt.testdataDir)
+
+			if tt.wantNil {
+				assert.Nil(t, workspace, "ParseDenoWorkspace() should return nil for %s", tt.testdataDir)
+				return
+			}
+
+			require.NotNil(t, workspace, "ParseDenoWorkspace() should return workspace for %s", tt.testdataDir)
+			assert.Equal(t, "deno", workspace.Name(), "ParseDenoWorkspace() workspace name should be 'deno'")
+		})
+	}
+}
+
+func TestDenoWorkspace_Name(t *testing.T) {
+	workspace := &jsmanager.DenoWorkspace{}
+	got := workspace.Name()
+
+	assert.Equal(t, "deno", got, "DenoWorkspace.Name() should return 'deno'")
+}
+
+func TestDenoWorkspace_Cmd(t *testing.T) {
+	workspace := &jsmanager.DenoWorkspace{}
+	cmd := workspace.Cmd()
+
+	expectedArgs := []string{"deno", "task"}
+	assert.Equal(t, expectedArgs, cmd.Args, "DenoWorkspace.Cmd() should return correct args")
+}
+
+func TestDenoWorkspace_InstallCmd(t *testing.T) {
+	workspace := &jsmanager.DenoWorkspace{}
+	cmd := workspace.InstallCmd()
+
+	expectedArgs := []string{"deno", "install"}
+	assert.Equal(t, expectedArgs, cmd.Args, "DenoWorkspace.InstallCmd() should return correct args")
+}
+
+func TestDenoWorkspace_ExecuteCmd(t *testing.T) {
+	workspace := &jsmanager.DenoWorkspace{}
+	cmd := workspace.ExecuteCmd()
+
+	expectedArgs := []string{"deno", "run"}
+	assert.Equal(t, expectedArgs, cmd.Args, "DenoWorkspace.ExecuteCmd() should return correct args")
+}
+
+func TestDenoWorkspace_AddCmd(t *testing.T) {
+	workspace := &jsmanager.DenoWorkspace{}
+	cmd := workspace.AddCmd()
+
+	expectedArgs := []string{"deno", "add"}
+	assert.Equal(t, expectedArgs, cmd.Args, "DenoWorkspace.AddCmd() should return correct args")
+}
+
+func TestDenoWorkspace_RemoveCmd(t *testing.T) {
+	workspace := &jsmanager.DenoWorkspace{}
+	cmd := workspace.RemoveCmd()
+
+	expectedArgs := []string{"deno", "remove"}
+	assert.Equal(t, expectedArgs, cmd.Args, "DenoWorkspace.RemoveCmd() should return correct args")
+}
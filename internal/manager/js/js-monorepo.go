@@ -0,0 +1,248 @@
+package jsmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	config "github.com/dmitriy-rs/rollercoaster/internal/manager/config-file"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+)
+
+// JsMonorepoManager lists scripts of every workspace package as "<package>#<script>"
+type JsMonorepoManager struct {
+	workspace *JsWorkspace
+	packages  []jsWorkspacePackage
+	filename  string
+	dir       string
+}
+
+type jsWorkspacePackage struct {
+	Name    string
+	Dir     string
+	Scripts map[string]string
+}
+
+// packageJsonWorkspaces supports both `"workspaces": [...]` and `"workspaces": {"packages": [...]}`
+type packageJsonWorkspaces []string
+
+func (w *packageJsonWorkspaces) UnmarshalJSON(data []byte) error {
+	var patterns []string
+	if err := json.Unmarshal(data, &patterns); err == nil {
+		*w = patterns
+		return nil
+	}
+
+	var object struct {
+		Packages []string `json:"packages"`
+	}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+	*w = object.Packages
+	return nil
+}
+
+type pnpmWorkspaceConfig struct {
+	Packages []string `yaml:"packages"`
+}
+
+const pnpmWorkspaceFilename = "pnpm-workspace.yaml"
+
+const workspacePackageSeparator = "#"
+
+func ParseJsMonorepoManager(dir *string, workspace *JsWorkspace) (*JsMonorepoManager, error) {
+	if _, ok := (*workspace).(JsWorkspaceFilter); !ok {
+		return nil, nil
+	}
+
+	patterns, filename, err := parseWorkspacePatterns(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+
+	packages, err := findWorkspacePackages(dir, patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JsMonorepoManager{
+		workspace: workspace,
+		packages:  packages,
+		filename:  filename,
+		dir:       *dir,
+	}, nil
+}
+
+// parseWorkspacePatterns reads package globs from pnpm-workspace.yaml or the package.json workspaces field
+func parseWorkspacePatterns(dir *string) ([]string, string, error) {
+	pnpmWorkspaceFile := config.FindInDirectory(dir, pnpmWorkspaceFilename)
+	if pnpmWorkspaceFile != nil {
+		pnpmWorkspace, err := config.ParseFileAsYaml[pnpmWorkspaceConfig](pnpmWorkspaceFile)
+		if err != nil {
+			return nil, "", err
+		}
+		return pnpmWorkspace.Packages, pnpmWorkspaceFile.Filename, nil
+	}
+
+	packageJsonFile := config.FindInDirectory(dir, packageJsonFilename)
+	if packageJsonFile == nil {
+		return nil, "", nil
+	}
+	packageJson, err := config.ParseFileAsJson[packageJsonConfig](packageJsonFile)
+	if err != nil {
+		return nil, "", err
+	}
+	return packageJson.Workspaces, packageJsonFile.Filename, nil
+}
+
+func findWorkspacePackages(dir *string, patterns []string) ([]jsWorkspacePackage, error) {
+	included := []string{}
+	excluded := map[string]bool{}
+
+	for _, pattern := range patterns {
+		exclude := strings.HasPrefix(pattern, "!")
+		pattern = strings.TrimPrefix(strings.TrimPrefix(pattern, "!"), "./")
+
+		matches, err := matchWorkspaceGlob(*dir, strings.Split(filepath.ToSlash(pattern), "/"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid workspace pattern %s: %w", pattern, err)
+		}
+		for _, match := range matches {
+			if exclude {
+				excluded[match] = true
+			} else {
+				included = append(included, match)
+			}
+		}
+	}
+
+	root := filepath.Clean(*dir)
+	seen := map[string]bool{}
+	packages := []jsWorkspacePackage{}
+	for _, packageDir := range included {
+		if excluded[packageDir] || seen[packageDir] || filepath.Clean(packageDir) == root {
+			continue
+		}
+		seen[packageDir] = true
+
+		packageJsonFile := config.FindInDirectory(&packageDir, packageJsonFilename)
+		if packageJsonFile == nil {
+			continue
+		}
+		packageJson, err := config.ParseFileAsJson[packageJsonConfig](packageJsonFile)
+		if err != nil || packageJson.Name == "" {
+			continue
+		}
+		packages = append(packages, jsWorkspacePackage{
+			Name:    packageJson.Name,
+			Dir:     packageDir,
+			Scripts: packageJson.Scripts,
+		})
+	}
+	return packages, nil
+}
+
+// matchWorkspaceGlob expands glob segments relative to dir, "**" matches any depth
+func matchWorkspaceGlob(dir string, segments []string) ([]string, error) {
+	if len(segments) == 0 || (len(segments) == 1 && segments[0] == "") {
+		return []string{dir}, nil
+	}
+
+	segment, rest := segments[0], segments[1:]
+	if segment == "" || segment == "." {
+		return matchWorkspaceGlob(dir, rest)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil
+	}
+
+	matches := []string{}
+	if segment == "**" {
+		restMatches, err := matchWorkspaceGlob(dir, rest)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, restMatches...)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == "node_modules" || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+
+		if segment == "**" {
+			nestedMatches, err := matchWorkspaceGlob(path, segments)
+			if err != nil {
+				return nil, err
+			}
+			matches = append(matches, nestedMatches...)
+			continue
+		}
+
+		matched, err := filepath.Match(segment, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			restMatches, err := matchWorkspaceGlob(path, rest)
+			if err != nil {
+				return nil, err
+			}
+			matches = append(matches, restMatches...)
+		}
+	}
+	return matches, nil
+}
+
+func (m *JsMonorepoManager) ListTasks() ([]task.Task, error) {
+	tasks := []task.Task{}
+	for _, pkg := range m.packages {
+		for name, script := range pkg.Scripts {
+			tasks = append(tasks, task.Task{
+				Name:        pkg.Name + workspacePackageSeparator + name,
+				Description: script,
+			})
+		}
+	}
+	task.SortTasks(tasks)
+	return tasks, nil
+}
+
+func (m *JsMonorepoManager) ExecuteTask(task *task.Task, args ...string) error {
+	return manager.CommandExecute(m.TaskCommand(task), nil, args...)
+}
+
+func (m *JsMonorepoManager) TaskCommand(task *task.Task) *exec.Cmd {
+	packageName, script, _ := strings.Cut(task.Name, workspacePackageSeparator)
+
+	// ParseJsMonorepoManager only accepts workspaces implementing JsWorkspaceFilter
+	cmd := (*m.workspace).(JsWorkspaceFilter).FilterCmd(packageName)
+	cmd.Args = append(cmd.Args, script)
+	cmd.Dir = m.dir
+	return cmd
+}
+
+func (m *JsMonorepoManager) GetTitle() manager.Title {
+	return manager.Title{
+		Name:        (*m.workspace).Name(),
+		Description: "workspace packages from " + m.filename,
+	}
+}
+
+func (m *JsMonorepoManager) GetSource() manager.Source {
+	return manager.Source{
+		Filename: m.filename,
+		Dir:      m.dir,
+	}
+}
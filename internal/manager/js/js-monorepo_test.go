@@ -0,0 +1,126 @@
+package jsmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	jsmanager "github.com/dmitriy-rs/rollercoaster/internal/manager/js"
+	"github.com/dmitriy-rs/rollercoaster/internal/manager/js/mocks"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJsMonorepoManager(t *testing.T) {
+	tests := []struct {
+		name         string
+		testdataDir  string
+		wantNil      bool
+		wantFilename string
+		wantTasks    []task.Task
+	}{
+		{
+			name:         "pnpm-workspace.yaml with nested globs and exclusions",
+			testdataDir:  "pnpm-monorepo",
+			wantFilename: "pnpm-workspace.yaml",
+			wantTasks: []task.Task{
+				{Name: "@app/api#build", Description: "tsc"},
+				{Name: "@app/deep#test", Description: "vitest"},
+				{Name: "@app/ui#lint", Description: "eslint src"},
+				{Name: "@app/web#build", Description: "vite build"},
+				{Name: "@app/web#dev", Description: "vite"},
+			},
+		},
+		{
+			name:         "package.json workspaces object",
+			testdataDir:  "npm-monorepo",
+			wantFilename: "package.json",
+			wantTasks: []task.Task{
+				{Name: "cli#start", Description: "node index.js"},
+				{Name: "core#build", Description: "tsc -b"},
+			},
+		},
+		{
+			name:        "package.json without workspaces",
+			testdataDir: "with-scripts",
+			wantNil:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := filepath.Join("testdata", tt.testdataDir)
+
+			workspace, err := jsmanager.ParseJsWorkspace(&testDir, "npm")
+			require.NoError(t, err, "ParseJsWorkspace() should not return error")
+			require.NotNil(t, workspace, "ParseJsWorkspace() should return workspace")
+
+			manager, err := jsmanager.ParseJsMonorepoManager(&testDir, workspace)
+			require.NoError(t, err, "ParseJsMonorepoManager() should not return error")
+
+			if tt.wantNil {
+				assert.Nil(t, manager, "ParseJsMonorepoManager() should return nil for %s", tt.testdataDir)
+				return
+			}
+			require.NotNil(t, manager, "ParseJsMonorepoManager() should return manager for %s", tt.testdataDir)
+
+			tasks, err := manager.ListTasks()
+			require.NoError(t, err, "ListTasks() should not return error")
+			assert.Equal(t, tt.wantTasks, tasks, "Should list scripts qualified by package name")
+
+			title := manager.GetTitle()
+			assert.Equal(t, (*workspace).Name(), title.Name, "Title name should match workspace name")
+			assert.Contains(t, title.Description, tt.wantFilename, "Title should mention the workspace definition file")
+		})
+	}
+}
+
+func TestParseJsMonorepoManager_WorkspaceWithoutFilter(t *testing.T) {
+	testDir := filepath.Join("testdata", "pnpm-monorepo")
+	workspace := mocks.NewMockJsWorkspace("mock").ToJsWorkspacePtr()
+
+	manager, err := jsmanager.ParseJsMonorepoManager(&testDir, workspace)
+	require.NoError(t, err, "ParseJsMonorepoManager() should not return error")
+	assert.Nil(t, manager, "Workspaces without package filtering should not list workspace packages")
+}
+
+func TestJsWorkspace_FilterCmd(t *testing.T) {
+	tests := []struct {
+		name      string
+		workspace jsmanager.JsWorkspaceFilter
+		expected  []string
+	}{
+		{
+			name:      "npm",
+			workspace: &jsmanager.NpmWorkspace{},
+			expected:  []string{"npm", "run", "--workspace", "@app/web"},
+		},
+		{
+			name:      "pnpm",
+			workspace: &jsmanager.PnpmWorkspace{},
+			expected:  []string{"pnpm", "--filter", "@app/web", "run"},
+		},
+		{
+			name:      "yarn",
+			workspace: &jsmanager.YarnWorkspace{},
+			expected:  []string{"yarn", "workspace", "@app/web", "run"},
+		},
+		{
+			name:      "yarn berry",
+			workspace: &jsmanager.YarnBerryWorkspace{},
+			expected:  []string{"yarn", "workspace", "@app/web", "run"},
+		},
+		{
+			name:      "bun",
+			workspace: &jsmanager.BunWorkspace{},
+			expected:  []string{"bun", "run", "--filter", "@app/web"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := tt.workspace.FilterCmd("@app/web")
+			assert.Equal(t, tt.expected, cmd.Args, "FilterCmd() should return correct args")
+		})
+	}
+}
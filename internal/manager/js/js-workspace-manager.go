@@ -7,6 +7,7 @@ import (
 	"github.com/dmitriy-rs/rollercoaster/internal/task"
 )
 
+// JsWorkspaceManager commands (add, npx, ...) are not defined by a manifest and always run in the invoking directory
 type JsWorkspaceManager struct {
 	Workspace *JsWorkspace
 }
@@ -31,10 +32,20 @@ func (m *JsWorkspaceManager) ListTasks() ([]task.Task, error) {
 			Aliases:     []string{(*m.Workspace).ExecName()},
 		},
 	}
+	if _, ok := (*m.Workspace).(JsWorkspaceForeach); ok {
+		tasks = append(tasks, task.Task{
+			Name:        WorkspaceForeachTask,
+			Description: "Run a script in every workspace package",
+		})
+	}
 	return tasks, nil
 }
 
-func (m *JsWorkspaceManager) ExecuteTask(task *task.Task, args ...string) {
+func (m *JsWorkspaceManager) ExecuteTask(task *task.Task, args ...string) error {
+	return manager.CommandExecute(m.TaskCommand(task), nil, args...)
+}
+
+func (m *JsWorkspaceManager) TaskCommand(task *task.Task) *exec.Cmd {
 	var cmd *exec.Cmd
 
 	switch task.Name {
@@ -46,11 +57,19 @@ func (m *JsWorkspaceManager) ExecuteTask(task *task.Task, args ...string) {
 		cmd = (*m.Workspace).RemoveCmd()
 	case WorkspaceExecuteTask:
 		cmd = (*m.Workspace).ExecuteCmd()
+	case WorkspaceForeachTask:
+		if foreach, ok := (*m.Workspace).(JsWorkspaceForeach); ok {
+			cmd = foreach.ForeachCmd()
+			break
+		}
+		cmd = (*m.Workspace).Cmd()
+		cmd.Args = append(cmd.Args, task.Name)
 	default:
 		cmd = (*m.Workspace).Cmd()
+		cmd.Args = append(cmd.Args, task.Name)
 	}
 
-	manager.CommandExecute(cmd, append([]string{task.Name}, args...)...)
+	return cmd
 }
 
 func (m *JsWorkspaceManager) GetTitle() manager.Title {
@@ -21,12 +21,30 @@ type JsWorkspace interface {
 	RemoveCmd() *exec.Cmd
 }
 
+// JsWorkspaceFilter is implemented by workspaces which can run a script of a single workspace package
+type JsWorkspaceFilter interface {
+	FilterCmd(packageName string) *exec.Cmd
+}
+
+// JsWorkspaceForeach is implemented by workspaces which can run a script in every workspace package
+type JsWorkspaceForeach interface {
+	ForeachCmd() *exec.Cmd
+}
+
 func ParseJsWorkspace(dir *string, defaultJSManager string) (*JsWorkspace, error) {
-	_, err := os.Stat(filepath.Join(*dir, packageJsonFilename))
-	if err != nil {
+	if !hasProjectFile(dir) {
 		return nil, nil
 	}
 
+	// The packageManager field is what corepack enforces, so it wins over lockfile detection
+	packageManagerWorkspace, err := parsePackageManagerWorkspace(dir)
+	if err != nil {
+		logger.Warning(err.Error())
+	}
+	if packageManagerWorkspace != nil {
+		return &packageManagerWorkspace, nil
+	}
+
 	workspaces := []JsWorkspace{}
 
 	pnpmWorkspace, err := ParsePnpmWorkspace(dir)
@@ -37,12 +55,36 @@ func ParseJsWorkspace(dir *string, defaultJSManager string) (*JsWorkspace, error
 		workspaces = append(workspaces, pnpmWorkspace)
 	}
 
-	yarnWorkspace, err := ParseYarnWorkspace(dir)
+	yarnBerryWorkspace, err := ParseYarnBerryWorkspace(dir)
+	if err != nil {
+		logger.Warning(err.Error())
+	}
+	if yarnBerryWorkspace != nil {
+		workspaces = append(workspaces, yarnBerryWorkspace)
+	} else {
+		yarnWorkspace, err := ParseYarnWorkspace(dir)
+		if err != nil {
+			logger.Warning(err.Error())
+		}
+		if yarnWorkspace != nil {
+			workspaces = append(workspaces, yarnWorkspace)
+		}
+	}
+
+	denoWorkspace, err := ParseDenoWorkspace(dir)
+	if err != nil {
+		logger.Warning(err.Error())
+	}
+	if denoWorkspace != nil {
+		workspaces = append(workspaces, denoWorkspace)
+	}
+
+	bunWorkspace, err := ParseBunWorkspace(dir)
 	if err != nil {
 		logger.Warning(err.Error())
 	}
-	if yarnWorkspace != nil {
-		workspaces = append(workspaces, yarnWorkspace)
+	if bunWorkspace != nil {
+		workspaces = append(workspaces, bunWorkspace)
 	}
 
 	npmWorkspace, err := ParseNpmWorkspace(dir)
@@ -74,6 +116,17 @@ func ParseJsWorkspace(dir *string, defaultJSManager string) (*JsWorkspace, error
 	return &workspaces[0], nil
 }
 
+// hasProjectFile reports whether the directory is a node (package.json) or deno (deno.json) project
+func hasProjectFile(dir *string) bool {
+	filenames := append([]string{packageJsonFilename}, denoJsonFilenames[:]...)
+	for _, filename := range filenames {
+		if _, err := os.Stat(filepath.Join(*dir, filename)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
 func parseDefaultJSManager(defaultJSManager string) JsWorkspace {
 	switch defaultJSManager {
 	case "npm":
@@ -85,6 +138,12 @@ func parseDefaultJSManager(defaultJSManager string) JsWorkspace {
 	case "pnpm":
 		workspace := GetDefaultPnpmWorkspace()
 		return &workspace
+	case "bun":
+		workspace := GetDefaultBunWorkspace()
+		return &workspace
+	case "deno":
+		workspace := GetDefaultDenoWorkspace()
+		return &workspace
 	default:
 		return nil
 	}
@@ -96,4 +155,5 @@ var (
 	WorkspaceAddTask          = "add"
 	WorkspaceRemoveTask       = "remove"
 	WorkspaceExecuteTask      = "x"
+	WorkspaceForeachTask      = "foreach"
 )
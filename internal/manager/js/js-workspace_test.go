@@ -36,10 +36,58 @@ func TestParseJsWorkspace(t *testing.T) {
 			defaultManager: "",
 		},
 		{
-			name:           "yarn v2+ with lock (unsupported)",
+			name:           "yarn v2+ with lock",
 			testdataDir:    "yarn-v2-with-lock",
-			wantName:       "",
-			wantNil:        true,
+			wantName:       "yarn@3+",
+			wantNil:        false,
+			wantError:      false,
+			defaultManager: "",
+		},
+		{
+			name:           "bun with text lock",
+			testdataDir:    "bun-with-lock",
+			wantName:       "bun",
+			wantNil:        false,
+			wantError:      false,
+			defaultManager: "",
+		},
+		{
+			name:           "bun with binary lock",
+			testdataDir:    "bun-with-lockb",
+			wantName:       "bun",
+			wantNil:        false,
+			wantError:      false,
+			defaultManager: "",
+		},
+		{
+			name:           "deno with lock",
+			testdataDir:    "deno-with-lock",
+			wantName:       "deno",
+			wantNil:        false,
+			wantError:      false,
+			defaultManager: "",
+		},
+		{
+			name:           "deno.jsonc without lock files with default",
+			testdataDir:    "deno-jsonc",
+			wantName:       "deno",
+			wantNil:        false,
+			wantError:      false,
+			defaultManager: "deno",
+		},
+		{
+			name:           "yarn berry from packageManager field",
+			testdataDir:    "yarn-berry-package-manager",
+			wantName:       "yarn@4.5.0",
+			wantNil:        false,
+			wantError:      false,
+			defaultManager: "",
+		},
+		{
+			name:           "yarn v1 from packageManager field and lock",
+			testdataDir:    "yarn-v1-package-manager",
+			wantName:       "yarn@1.22.22",
+			wantNil:        false,
 			wantError:      false,
 			defaultManager: "",
 		},
@@ -83,6 +131,30 @@ func TestParseJsWorkspace(t *testing.T) {
 			wantError:      true,
 			defaultManager: "",
 		},
+		{
+			name:           "multiple locks disambiguated by packageManager",
+			testdataDir:    "multiple-locks-package-manager",
+			wantName:       "npm@10.8.2",
+			wantNil:        false,
+			wantError:      false,
+			defaultManager: "",
+		},
+		{
+			name:           "packageManager without lock files",
+			testdataDir:    "pnpm-package-manager",
+			wantName:       "pnpm@9.12.0",
+			wantNil:        false,
+			wantError:      false,
+			defaultManager: "npm",
+		},
+		{
+			name:           "unsupported packageManager falls back to lock files",
+			testdataDir:    "unknown-package-manager",
+			wantName:       "npm",
+			wantNil:        false,
+			wantError:      false,
+			defaultManager: "",
+		},
 		{
 			name:           "empty directory with default",
 			testdataDir:    "empty",
@@ -99,6 +171,14 @@ func TestParseJsWorkspace(t *testing.T) {
 			wantError:      false,
 			defaultManager: "npm",
 		},
+		{
+			name:           "package.json without lock files with bun default",
+			testdataDir:    "no-scripts",
+			wantName:       "bun",
+			wantNil:        false,
+			wantError:      false,
+			defaultManager: "bun",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1,6 +1,8 @@
 package jsmanager
 
 import (
+	"os/exec"
+
 	"github.com/dmitriy-rs/rollercoaster/internal/manager"
 	config "github.com/dmitriy-rs/rollercoaster/internal/manager/config-file"
 	"github.com/dmitriy-rs/rollercoaster/internal/task"
@@ -10,10 +12,14 @@ type JsManager struct {
 	workspace *JsWorkspace
 	config    packageJsonConfig
 	filename  string
+	dir       string
 }
 
 type packageJsonConfig struct {
-	Scripts map[string]string `json:"scripts"`
+	Name           string                `json:"name"`
+	Scripts        map[string]string     `json:"scripts"`
+	PackageManager string                `json:"packageManager"`
+	Workspaces     packageJsonWorkspaces `json:"workspaces"`
 }
 
 const packageJsonFilename = "package.json"
@@ -31,6 +37,7 @@ func ParseJsManager(dir *string, workspace *JsWorkspace) (*JsManager, error) {
 		config:    config,
 		filename:  packageJsonFile.Filename,
 		workspace: workspace,
+		dir:       *dir,
 	}
 
 	return manager, nil
@@ -47,9 +54,15 @@ func (m *JsManager) ListTasks() ([]task.Task, error) {
 	return tasks, nil
 }
 
-func (m *JsManager) ExecuteTask(task *task.Task, args ...string) {
+func (m *JsManager) ExecuteTask(task *task.Task, args ...string) error {
+	return manager.CommandExecute(m.TaskCommand(task), nil, args...)
+}
+
+func (m *JsManager) TaskCommand(task *task.Task) *exec.Cmd {
 	cmd := (*m.workspace).Cmd()
-	manager.CommandExecute(cmd, args...)
+	cmd.Args = append(cmd.Args, task.Name)
+	cmd.Dir = m.dir
+	return cmd
 }
 
 func (m *JsManager) GetTitle() manager.Title {
@@ -58,3 +71,10 @@ func (m *JsManager) GetTitle() manager.Title {
 		Description: "parsed from " + m.filename,
 	}
 }
+
+func (m *JsManager) GetSource() manager.Source {
+	return manager.Source{
+		Filename: m.filename,
+		Dir:      m.dir,
+	}
+}
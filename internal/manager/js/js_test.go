@@ -172,6 +172,21 @@ func TestJsManager_GetTitle(t *testing.T) {
 	}
 }
 
+func TestJsManager_GetTitleWithPackageManager(t *testing.T) {
+	testDir := filepath.Join("testdata", "pnpm-package-manager")
+
+	workspace, err := jsmanager.ParseJsWorkspace(&testDir, "")
+	require.NoError(t, err, "ParseJsWorkspace() should not return error")
+	require.NotNil(t, workspace, "ParseJsWorkspace() should return workspace")
+
+	manager, err := jsmanager.ParseJsManager(&testDir, workspace)
+	require.NoError(t, err, "ParseJsManager() should not return error")
+	require.NotNil(t, manager, "ParseJsManager() should return manager")
+
+	title := manager.GetTitle()
+	assert.Equal(t, "pnpm@9.12.0", title.Name, "Title name should include the pinned version")
+}
+
 func TestJsManager_ExecuteTaskWithMultipleArgs(t *testing.T) {
 	testDir := filepath.Join("testdata", "with-scripts")
 	mockWorkspace := mocks.NewMockJsWorkspace("yarn")
@@ -7,6 +7,7 @@ import (
 )
 
 type NpmWorkspace struct {
+	pinnedVersion string
 }
 
 const npmLockFilename = "package-lock.json"
@@ -24,6 +25,9 @@ func GetDefaultNpmWorkspace() NpmWorkspace {
 }
 
 func (m *NpmWorkspace) Name() string {
+	if m.pinnedVersion != "" {
+		return "npm@" + m.pinnedVersion
+	}
 	return "npm"
 }
 
@@ -50,3 +54,7 @@ func (m *NpmWorkspace) AddCmd() *exec.Cmd {
 func (m *NpmWorkspace) RemoveCmd() *exec.Cmd {
 	return exec.Command("npm", "uninstall")
 }
+
+func (m *NpmWorkspace) FilterCmd(packageName string) *exec.Cmd {
+	return exec.Command("npm", "run", "--workspace", packageName)
+}
@@ -0,0 +1,63 @@
+package jsmanager
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	config "github.com/dmitriy-rs/rollercoaster/internal/manager/config-file"
+)
+
+// parsePackageManager reads the corepack `packageManager` field of package.json,
+// e.g. "pnpm@9.12.0+sha512.abc" is returned as ("pnpm", "9.12.0")
+func parsePackageManager(dir *string) (string, string, bool) {
+	packageJsonFile := config.FindInDirectory(dir, packageJsonFilename)
+	if packageJsonFile == nil {
+		return "", "", false
+	}
+	packageJson, err := config.ParseFileAsJson[packageJsonConfig](packageJsonFile)
+	if err != nil || packageJson.PackageManager == "" {
+		return "", "", false
+	}
+
+	name, version, ok := strings.Cut(packageJson.PackageManager, "@")
+	if !ok || name == "" {
+		return "", "", false
+	}
+	version, _, _ = strings.Cut(version, "+")
+	return name, version, true
+}
+
+func parseMajorVersion(version string) (int, error) {
+	major, _, _ := strings.Cut(version, ".")
+	return strconv.Atoi(major)
+}
+
+// parsePackageManagerWorkspace creates the workspace pinned by the packageManager field
+func parsePackageManagerWorkspace(dir *string) (JsWorkspace, error) {
+	name, version, ok := parsePackageManager(dir)
+	if !ok {
+		return nil, nil
+	}
+
+	major, err := parseMajorVersion(version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid packageManager version: %s@%s", name, version)
+	}
+
+	switch name {
+	case "npm":
+		return &NpmWorkspace{pinnedVersion: version}, nil
+	case "pnpm":
+		return &PnpmWorkspace{version: major, pinnedVersion: version}, nil
+	case "yarn":
+		if major < 2 {
+			return &YarnWorkspace{version: major, pinnedVersion: version}, nil
+		}
+		return &YarnBerryWorkspace{version: major, pinnedVersion: version}, nil
+	case "bun":
+		return &BunWorkspace{pinnedVersion: version}, nil
+	default:
+		return nil, fmt.Errorf("unsupported packageManager: %s", name)
+	}
+}
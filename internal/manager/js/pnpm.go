@@ -10,7 +10,8 @@ import (
 )
 
 type PnpmWorkspace struct {
-	version int
+	version       int
+	pinnedVersion string
 }
 
 const pnpmLockFilename = "pnpm-lock.yaml"
@@ -48,6 +49,9 @@ func GetDefaultPnpmWorkspace() PnpmWorkspace {
 }
 
 func (m *PnpmWorkspace) Name() string {
+	if m.pinnedVersion != "" {
+		return "pnpm@" + m.pinnedVersion
+	}
 	switch m.version {
 	case 10:
 		return "pnpm@10+"
@@ -81,3 +85,7 @@ func (m *PnpmWorkspace) AddCmd() *exec.Cmd {
 func (m *PnpmWorkspace) RemoveCmd() *exec.Cmd {
 	return exec.Command("pnpm", "remove")
 }
+
+func (m *PnpmWorkspace) FilterCmd(packageName string) *exec.Cmd {
+	return exec.Command("pnpm", "--filter", packageName, "run")
+}
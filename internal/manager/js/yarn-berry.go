@@ -0,0 +1,141 @@
+package jsmanager
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// YarnBerryWorkspace is yarn 2+ (berry) which replaced `yarn run` bins with `yarn dlx`
+// and manages monorepos with `yarn workspaces foreach`
+type YarnBerryWorkspace struct {
+	version       int
+	pinnedVersion string
+}
+
+const yarnrcFilename = ".yarnrc.yml"
+
+func ParseYarnBerryWorkspace(dir *string) (*YarnBerryWorkspace, error) {
+	if name, version, ok := parsePackageManager(dir); ok && name == "yarn" {
+		major, err := parseMajorVersion(version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid packageManager version: yarn@%s", version)
+		}
+		if major < 2 {
+			return nil, nil
+		}
+		return &YarnBerryWorkspace{version: major, pinnedVersion: version}, nil
+	}
+
+	lockfileVersion, err := parseYarnLockfileMetadataVersion(dir)
+	if err != nil {
+		return nil, err
+	}
+	if lockfileVersion > 0 {
+		return &YarnBerryWorkspace{version: yarnVersionFromLockfile(lockfileVersion)}, nil
+	}
+
+	yarnrcFile, err := os.Stat(filepath.Join(*dir, yarnrcFilename))
+	if err == nil && !yarnrcFile.IsDir() {
+		return &YarnBerryWorkspace{}, nil
+	}
+
+	return nil, nil
+}
+
+// parseYarnLockfileMetadataVersion reads `__metadata.version` which only exists in berry lockfiles.
+// Returns 0 when there is no lockfile or it is a yarn v1 lockfile.
+func parseYarnLockfileMetadataVersion(dir *string) (int, error) {
+	yarnLockFile, err := os.OpenFile(filepath.Join(*dir, yarnLockFilename), os.O_RDONLY, 0644)
+	if err != nil {
+		return 0, nil
+	}
+	defer yarnLockFile.Close() //nolint:errcheck
+
+	inMetadata := false
+	scanner := bufio.NewScanner(yarnLockFile)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "__metadata:") {
+			inMetadata = true
+			continue
+		}
+		if !inMetadata {
+			continue
+		}
+		if !strings.HasPrefix(line, " ") {
+			break
+		}
+		version, ok := strings.CutPrefix(strings.TrimSpace(line), "version:")
+		if !ok {
+			continue
+		}
+		lockfileVersion, err := strconv.Atoi(strings.TrimSpace(version))
+		if err != nil {
+			return 0, fmt.Errorf("unsupported yarn lockfile version: %s", strings.TrimSpace(version))
+		}
+		return lockfileVersion, nil
+	}
+	return 0, nil
+}
+
+func yarnVersionFromLockfile(lockfileVersion int) int {
+	switch {
+	case lockfileVersion >= 7:
+		return 4
+	case lockfileVersion >= 5:
+		return 3
+	default:
+		return 2
+	}
+}
+
+func GetDefaultYarnBerryWorkspace() YarnBerryWorkspace {
+	return YarnBerryWorkspace{version: 4}
+}
+
+func (m *YarnBerryWorkspace) Name() string {
+	if m.pinnedVersion != "" {
+		return "yarn@" + m.pinnedVersion
+	}
+	if m.version < 2 {
+		return "yarn@2+"
+	}
+	return fmt.Sprintf("yarn@%d+", m.version)
+}
+
+func (m *YarnBerryWorkspace) ExecName() string {
+	return "yarn dlx"
+}
+
+func (m *YarnBerryWorkspace) Cmd() *exec.Cmd {
+	return exec.Command("yarn", "run")
+}
+
+func (m *YarnBerryWorkspace) InstallCmd() *exec.Cmd {
+	return exec.Command("yarn", "install")
+}
+
+func (m *YarnBerryWorkspace) ExecuteCmd() *exec.Cmd {
+	return exec.Command("yarn", "dlx")
+}
+
+func (m *YarnBerryWorkspace) AddCmd() *exec.Cmd {
+	return exec.Command("yarn", "add")
+}
+
+func (m *YarnBerryWorkspace) RemoveCmd() *exec.Cmd {
+	return exec.Command("yarn", "remove")
+}
+
+func (m *YarnBerryWorkspace) ForeachCmd() *exec.Cmd {
+	return exec.Command("yarn", "workspaces", "foreach", "--all", "--topological", "run")
+}
+
+func (m *YarnBerryWorkspace) FilterCmd(packageName string) *exec.Cmd {
+	return exec.Command("yarn", "workspace", packageName, "run")
+}
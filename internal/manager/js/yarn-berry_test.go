@@ -0,0 +1,104 @@
+package jsmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	jsmanager "github.com/dmitriy-rs/rollercoaster/internal/manager/js"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseYarnBerryWorkspace(t *testing.T) {
+	tests := []struct {
+		name        string
+		testdataDir string
+		wantName    string
+		wantNil     bool
+	}{
+		{
+			name:        "yarn v3 lockfile metadata",
+			testdataDir: "yarn-v2-with-lock",
+			wantName:    "yarn@3+",
+		},
+		{
+			name:        "yarn v4 lockfile metadata",
+			testdataDir: "yarn-v4-with-lock",
+			wantName:    "yarn@4+",
+		},
+		{
+			name:        "packageManager field without lockfile",
+			testdataDir: "yarn-berry-package-manager",
+			wantName:    "yarn@4.5.0",
+		},
+		{
+			name:        ".yarnrc.yml without lockfile",
+			testdataDir: "yarn-berry-yarnrc",
+			wantName:    "yarn@2+",
+		},
+		{
+			name:        "yarn v1 lockfile",
+			testdataDir: "yarn-v1-with-lock",
+			wantNil:     true,
+		},
+		{
+			name:        "yarn v1 lockfile with npm alias",
+			testdataDir: "yarn-v1-npm-alias",
+			wantNil:     true,
+		},
+		{
+			name:        "yarn v1 packageManager field",
+			testdataDir: "yarn-v1-package-manager",
+			wantNil:     true,
+		},
+		{
+			name:        "empty directory",
+			testdataDir: "empty",
+			wantNil:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := filepath.Join("testdata", tt.testdataDir)
+			workspace, err := jsmanager.ParseYarnBerryWorkspace(&testDir)
+			require.NoError(t, err, "ParseYarnBerryWorkspace() should not return error for %s", tt.testdataDir)
+
+			if tt.wantNil {
+				assert.Nil(t, workspace, "ParseYarnBerryWorkspace() should return nil for %s", tt.testdataDir)
+				return
+			}
+
+			require.NotNil(t, workspace, "ParseYarnBerryWorkspace() should return workspace for %s", tt.testdataDir)
+			assert.Equal(t, tt.wantName, workspace.Name(), "Name() should include the yarn major version")
+		})
+	}
+}
+
+func TestYarnBerryWorkspace_Commands(t *testing.T) {
+	workspace := &jsmanager.YarnBerryWorkspace{}
+
+	assert.Equal(t, "yarn dlx", workspace.ExecName(), "ExecName() should be 'yarn dlx'")
+	assert.Equal(t, []string{"yarn", "run"}, workspace.Cmd().Args, "Cmd() should return correct args")
+	assert.Equal(t, []string{"yarn", "install"}, workspace.InstallCmd().Args, "InstallCmd() should return correct args")
+	assert.Equal(t, []string{"yarn", "dlx"}, workspace.ExecuteCmd().Args, "ExecuteCmd() should return correct args")
+	assert.Equal(t, []string{"yarn", "add"}, workspace.AddCmd().Args, "AddCmd() should return correct args")
+	assert.Equal(t, []string{"yarn", "remove"}, workspace.RemoveCmd().Args, "RemoveCmd() should return correct args")
+	assert.Equal(t, []string{"yarn", "workspaces", "foreach", "--all", "--topological", "run"}, workspace.ForeachCmd().Args, "ForeachCmd() should return correct args")
+}
+
+func TestYarnBerryWorkspace_ForeachTask(t *testing.T) {
+	var workspace jsmanager.JsWorkspace = &jsmanager.YarnBerryWorkspace{}
+	manager := &jsmanager.JsWorkspaceManager{
+		Workspace: &workspace,
+	}
+
+	tasks, err := manager.ListTasks()
+	require.NoError(t, err, "ListTasks() should not return an error")
+
+	assert.Contains(t, tasks, task.Task{
+		Name:        "foreach",
+		Description: "Run a script in every workspace package",
+	}, "Yarn berry workspace should expose the foreach task")
+}
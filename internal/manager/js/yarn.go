@@ -2,7 +2,6 @@ package jsmanager
 
 import (
 	"bufio"
-	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -10,7 +9,8 @@ import (
 )
 
 type YarnWorkspace struct {
-	version int
+	version       int
+	pinnedVersion string
 }
 
 const yarnLockFilename = "yarn.lock"
@@ -25,8 +25,10 @@ func ParseYarnWorkspace(dir *string) (*YarnWorkspace, error) {
 	scanner := bufio.NewScanner(yarnLockFile)
 	for scanner.Scan() {
 		line := scanner.Text()
-		if strings.Contains(line, "@npm:") {
-			return nil, fmt.Errorf("yarn 2+ lockfile unsupported")
+		// yarn 2+ lockfile, handled by YarnBerryWorkspace
+		// v1 lockfiles may contain "@npm:" in npm aliases, only berry has the metadata key
+		if strings.HasPrefix(line, "__metadata:") {
+			return nil, nil
 		}
 	}
 
@@ -38,6 +40,9 @@ func GetDefaultYarnWorkspace() YarnWorkspace {
 }
 
 func (m *YarnWorkspace) Name() string {
+	if m.pinnedVersion != "" {
+		return "yarn@" + m.pinnedVersion
+	}
 	return "yarn"
 }
 
@@ -64,3 +69,7 @@ func (m *YarnWorkspace) AddCmd() *exec.Cmd {
 func (m *YarnWorkspace) RemoveCmd() *exec.Cmd {
 	return exec.Command("yarn", "remove")
 }
+
+func (m *YarnWorkspace) FilterCmd(packageName string) *exec.Cmd {
+	return exec.Command("yarn", "workspace", packageName, "run")
+}
@@ -25,11 +25,18 @@ func TestParseYarnWorkspace(t *testing.T) {
 			wantError:   false,
 		},
 		{
-			name:        "yarn v2+ with lock (unsupported)",
+			name:        "yarn v1 with npm alias in lock",
+			testdataDir: "yarn-v1-npm-alias",
+			wantVersion: 1,
+			wantNil:     false,
+			wantError:   false,
+		},
+		{
+			name:        "yarn v2+ with lock (handled by yarn berry)",
 			testdataDir: "yarn-v2-with-lock",
 			wantVersion: 0,
 			wantNil:     true,
-			wantError:   true,
+			wantError:   false,
 		},
 		{
 			name:        "empty directory (no yarn.lock)",
@@ -0,0 +1,319 @@
+package justmanager
+
+import (
+	"bufio"
+	"bytes"
+	"os/exec"
+	"strings"
+	"unicode"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	config "github.com/dmitriy-rs/rollercoaster/internal/manager/config-file"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+)
+
+type JustManager struct {
+	recipes  []justRecipe
+	filename string
+	dir      string
+}
+
+type justRecipe struct {
+	Name       string
+	Doc        string
+	Parameters []string
+	Aliases    []string
+	Private    bool
+}
+
+var justFilenames = [3]string{
+	"justfile",
+	"Justfile",
+	".justfile",
+}
+
+func ParseJustManager(dir *string) (*JustManager, error) {
+	justFile := config.FindFirstInDirectory(dir, justFilenames[:])
+	if justFile == nil {
+		return nil, nil
+	}
+
+	return &JustManager{
+		recipes:  parseRecipes(justFile.File),
+		filename: justFile.Filename,
+		dir:      *dir,
+	}, nil
+}
+
+func parseRecipes(file []byte) []justRecipe {
+	recipes := []justRecipe{}
+	aliases := map[string][]string{}
+
+	doc := ""
+	attributes := []string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(file))
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+
+		// Recipe body or continuation
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			doc = ""
+			attributes = attributes[:0]
+			continue
+		case strings.HasPrefix(trimmed, "#!"):
+			continue
+		case strings.HasPrefix(trimmed, "#"):
+			doc = strings.TrimSpace(strings.TrimPrefix(trimmed, "#"))
+			continue
+		case strings.HasPrefix(trimmed, "["):
+			attributes = append(attributes, parseAttributes(trimmed)...)
+			continue
+		}
+
+		if name, target, ok := parseAlias(trimmed); ok {
+			if !hasAttribute(attributes, "private") && !strings.HasPrefix(name, "_") {
+				aliases[target] = append(aliases[target], name)
+			}
+			doc = ""
+			attributes = attributes[:0]
+			continue
+		}
+
+		if recipe, ok := parseRecipeHeader(trimmed); ok {
+			recipe.Doc = doc
+			for _, attribute := range attributes {
+				if value, ok := attributeValue(attribute, "doc"); ok {
+					recipe.Doc = value
+				}
+			}
+			recipe.Private = strings.HasPrefix(recipe.Name, "_") || hasAttribute(attributes, "private")
+			recipes = append(recipes, recipe)
+		}
+
+		doc = ""
+		attributes = attributes[:0]
+	}
+
+	for i := range recipes {
+		recipes[i].Aliases = aliases[recipes[i].Name]
+	}
+
+	return recipes
+}
+
+// parseAttributes splits "[private, group('ci')]" into ["private", "group('ci')"]
+func parseAttributes(line string) []string {
+	line = strings.TrimSuffix(strings.TrimPrefix(line, "["), "]")
+	attributes := []string{}
+	for _, attribute := range splitOutsideQuotes(line, func(r rune) bool { return r == ',' }) {
+		attribute = strings.TrimSpace(attribute)
+		if attribute != "" {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+func hasAttribute(attributes []string, name string) bool {
+	for _, attribute := range attributes {
+		if attribute == name {
+			return true
+		}
+	}
+	return false
+}
+
+// attributeValue extracts the argument of attributes like doc('text') or doc: 'text'
+func attributeValue(attribute, name string) (string, bool) {
+	value, ok := strings.CutPrefix(attribute, name)
+	if !ok {
+		return "", false
+	}
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "(") {
+		value = strings.TrimSuffix(strings.TrimPrefix(value, "("), ")")
+	} else {
+		value = strings.TrimPrefix(value, ":")
+	}
+	return unquote(strings.TrimSpace(value)), true
+}
+
+// parseAlias parses "alias b := build"
+func parseAlias(line string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(line, "alias ")
+	if !ok {
+		return "", "", false
+	}
+	name, target, ok := strings.Cut(rest, ":=")
+	if !ok {
+		return "", "", false
+	}
+	return strings.TrimSpace(name), strings.TrimSpace(target), true
+}
+
+// parseRecipeHeader parses "@deploy env target='prod': build" into a recipe
+func parseRecipeHeader(line string) (justRecipe, bool) {
+	for _, keyword := range []string{"set ", "export ", "import ", "import? ", "mod ", "mod? "} {
+		if strings.HasPrefix(line, keyword) {
+			return justRecipe{}, false
+		}
+	}
+
+	colon := indexOutsideQuotes(line, ':')
+	if colon <= 0 {
+		return justRecipe{}, false
+	}
+	// Assignment: name := value
+	if strings.HasPrefix(line[colon+1:], "=") {
+		return justRecipe{}, false
+	}
+
+	fields := splitOutsideQuotes(line[:colon], unicode.IsSpace)
+	if len(fields) == 0 {
+		return justRecipe{}, false
+	}
+
+	name := strings.TrimPrefix(fields[0], "@")
+	if !isIdentifier(name) {
+		return justRecipe{}, false
+	}
+
+	return justRecipe{
+		Name:       name,
+		Parameters: fields[1:],
+	}, true
+}
+
+func isIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		if r == '_' || unicode.IsLetter(r) || (i > 0 && (r == '-' || unicode.IsDigit(r))) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+func indexOutsideQuotes(line string, target rune) int {
+	var quote rune
+	depth := 0
+	for i, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == target && depth == 0:
+			return i
+		}
+	}
+	return -1
+}
+
+func splitOutsideQuotes(line string, isSeparator func(rune) bool) []string {
+	fields := []string{}
+	current := strings.Builder{}
+	var quote rune
+	depth := 0
+	for _, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case depth == 0 && isSeparator(r):
+			if current.Len() > 0 {
+				fields = append(fields, current.String())
+				current.Reset()
+			}
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if current.Len() > 0 {
+		fields = append(fields, current.String())
+	}
+	return fields
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '\'' || value[0] == '"') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+func (m *JustManager) ListTasks() ([]task.Task, error) {
+	tasks := []task.Task{}
+	for _, recipe := range m.recipes {
+		if recipe.Private {
+			continue
+		}
+		tasks = append(tasks, task.Task{
+			Name:        recipe.Name,
+			Description: recipe.description(),
+			Aliases:     recipe.Aliases,
+		})
+	}
+	task.SortTasks(tasks)
+	return tasks, nil
+}
+
+// description follows the `just --list` format: "param1 param2='default' # doc"
+func (r justRecipe) description() string {
+	parameters := strings.Join(r.Parameters, " ")
+	switch {
+	case parameters == "":
+		return r.Doc
+	case r.Doc == "":
+		return parameters
+	default:
+		return parameters + " # " + r.Doc
+	}
+}
+
+func (m *JustManager) ExecuteTask(task *task.Task, args ...string) error {
+	return manager.CommandExecute(m.TaskCommand(task), nil, args...)
+}
+
+func (m *JustManager) TaskCommand(task *task.Task) *exec.Cmd {
+	cmd := exec.Command("just", task.Name)
+	cmd.Dir = m.dir
+	return cmd
+}
+
+func (m *JustManager) GetTitle() manager.Title {
+	return manager.Title{
+		Name:        "just",
+		Description: "parsed from " + m.filename,
+	}
+}
+
+func (m *JustManager) GetSource() manager.Source {
+	return manager.Source{
+		Filename: m.filename,
+		Dir:      m.dir,
+	}
+}
@@ -0,0 +1,78 @@
+package justmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	manager "github.com/dmitriy-rs/rollercoaster/internal/manager/just-manager"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJustManager(t *testing.T) {
+	tests := []struct {
+		name         string
+		testdataDir  string
+		wantNil      bool
+		wantFilename string
+		wantTasks    []task.Task
+	}{
+		{
+			name:         "justfile with aliases, parameters and private recipes",
+			testdataDir:  "justfile",
+			wantFilename: "justfile",
+			wantTasks: []task.Task{
+				{Name: "build", Description: "Build the project", Aliases: []string{"b"}},
+				{Name: "deploy", Description: `env target="app:latest" +flags # Deploy to an environment`},
+				{Name: "fmt", Description: "*args"},
+				{Name: "lint", Description: "Lint the code"},
+				{Name: "test", Description: "filter='' # Run tests", Aliases: []string{"t"}},
+			},
+		},
+		{
+			name:         "hidden .justfile",
+			testdataDir:  "dot-justfile",
+			wantFilename: ".justfile",
+			wantTasks: []task.Task{
+				{Name: "run", Description: "Run the app"},
+			},
+		},
+		{
+			name:         "capitalized Justfile with colon in default value",
+			testdataDir:  "capitalized",
+			wantFilename: "Justfile",
+			wantTasks: []task.Task{
+				{Name: "serve", Description: `port=":8080" # Serve the app`},
+			},
+		},
+		{
+			name:        "directory without justfile",
+			testdataDir: "empty",
+			wantNil:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := filepath.Join("testdata", tt.testdataDir)
+
+			jm, err := manager.ParseJustManager(&testDir)
+			require.NoError(t, err, "ParseJustManager() should not return error")
+
+			if tt.wantNil {
+				assert.Nil(t, jm, "ParseJustManager() should return nil for %s", tt.testdataDir)
+				return
+			}
+			require.NotNil(t, jm, "ParseJustManager() should return manager for %s", tt.testdataDir)
+
+			tasks, err := jm.ListTasks()
+			require.NoError(t, err, "ListTasks() should not return error")
+			assert.Equal(t, tt.wantTasks, tasks, "Should list public recipes sorted by name")
+
+			title := jm.GetTitle()
+			assert.Equal(t, "just", title.Name, "Title name should be 'just'")
+			assert.Contains(t, title.Description, tt.wantFilename, "Title should mention the parsed file")
+		})
+	}
+}
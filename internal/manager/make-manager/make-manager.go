@@ -0,0 +1,226 @@
+package makemanager
+
+import (
+	"bufio"
+	"bytes"
+	"os/exec"
+	"strings"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	config "github.com/dmitriy-rs/rollercoaster/internal/manager/config-file"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+)
+
+type MakeManager struct {
+	targets  []makeTarget
+	filename string
+	dir      string
+}
+
+type makeTarget struct {
+	Name        string
+	Description string
+}
+
+// Same lookup order as GNU make uses when no -f flag is provided
+var makeFilenames = [3]string{
+	"GNUmakefile",
+	"makefile",
+	"Makefile",
+}
+
+const descriptionPrefix = "##"
+
+func ParseMakeManager(dir *string) (*MakeManager, error) {
+	makeFile := config.FindFirstInDirectory(dir, makeFilenames[:])
+	if makeFile == nil {
+		return nil, nil
+	}
+
+	return &MakeManager{
+		targets:  parseTargets(makeFile.File),
+		filename: makeFile.Filename,
+		dir:      *dir,
+	}, nil
+}
+
+func parseTargets(file []byte) []makeTarget {
+	targets := []makeTarget{}
+	targetIndexes := map[string]int{}
+	phonyTargets := map[string]bool{}
+
+	addTarget := func(name, description string) {
+		if i, ok := targetIndexes[name]; ok {
+			if targets[i].Description == "" {
+				targets[i].Description = description
+			}
+			return
+		}
+		targetIndexes[name] = len(targets)
+		targets = append(targets, makeTarget{Name: name, Description: description})
+	}
+
+	pendingDescription := ""
+	inDefine := false
+	for _, line := range readLogicalLines(file) {
+		if strings.HasPrefix(line, "\t") {
+			// Recipe line
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if inDefine {
+			inDefine = trimmed != "endef"
+			continue
+		}
+		if strings.HasPrefix(trimmed, "define ") {
+			inDefine = true
+			continue
+		}
+		if trimmed == "" {
+			pendingDescription = ""
+			continue
+		}
+		if strings.HasPrefix(trimmed, descriptionPrefix) {
+			pendingDescription = strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+			continue
+		}
+		if strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		description := pendingDescription
+		pendingDescription = ""
+
+		rule, inlineDescription, hasInlineDescription := strings.Cut(line, descriptionPrefix)
+		if hasInlineDescription {
+			description = strings.TrimSpace(inlineDescription)
+		}
+		rule, _, _ = strings.Cut(rule, "#")
+
+		names, prerequisites, ok := splitRule(rule)
+		if !ok {
+			continue
+		}
+
+		for _, name := range names {
+			if name == ".PHONY" {
+				for _, phony := range prerequisites {
+					phonyTargets[phony] = true
+				}
+				continue
+			}
+			if !isExplicitTarget(name) {
+				continue
+			}
+			addTarget(name, description)
+		}
+	}
+
+	for name := range phonyTargets {
+		if !isExplicitTarget(name) {
+			continue
+		}
+		addTarget(name, "")
+	}
+
+	return targets
+}
+
+// readLogicalLines joins lines ending with a backslash the same way make does
+func readLogicalLines(file []byte) []string {
+	lines := []string{}
+	current := strings.Builder{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(file))
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if strings.HasSuffix(line, "\\") {
+			current.WriteString(strings.TrimSuffix(line, "\\"))
+			current.WriteString(" ")
+			continue
+		}
+		current.WriteString(line)
+		lines = append(lines, current.String())
+		current.Reset()
+	}
+	if current.Len() > 0 {
+		lines = append(lines, current.String())
+	}
+	return lines
+}
+
+// splitRule splits a rule line into target names and prerequisites.
+// Variable assignments and directives are not rules.
+func splitRule(line string) ([]string, []string, bool) {
+	colon := strings.Index(line, ":")
+	if colon <= 0 {
+		return nil, nil, false
+	}
+	// Variable assignments: VAR := value, VAR ::= value, VAR = a:b, VAR ?= a:b
+	if strings.ContainsAny(line[:colon], "=") {
+		return nil, nil, false
+	}
+	rest := strings.TrimPrefix(line[colon+1:], ":")
+	rest, _, _ = strings.Cut(rest, ";")
+	// Also covers target-specific variables: target: VAR = value
+	if strings.Contains(rest, "=") {
+		return nil, nil, false
+	}
+
+	names := strings.Fields(line[:colon])
+	if len(names) == 0 {
+		return nil, nil, false
+	}
+	switch names[0] {
+	case "include", "-include", "sinclude", "export", "override", "ifeq", "ifneq", "ifdef", "ifndef":
+		return nil, nil, false
+	}
+
+	return names, strings.Fields(rest), true
+}
+
+// isExplicitTarget filters out special (.PHONY, .DEFAULT), pattern (%.o)
+// and variable based ($(BIN)) targets which can't be run by name
+func isExplicitTarget(name string) bool {
+	if strings.HasPrefix(name, ".") {
+		return false
+	}
+	return !strings.ContainsAny(name, "%$")
+}
+
+func (m *MakeManager) ListTasks() ([]task.Task, error) {
+	tasks := make([]task.Task, 0, len(m.targets))
+	for _, target := range m.targets {
+		tasks = append(tasks, task.Task{
+			Name:        target.Name,
+			Description: target.Description,
+		})
+	}
+	task.SortTasks(tasks)
+	return tasks, nil
+}
+
+func (m *MakeManager) ExecuteTask(task *task.Task, args ...string) error {
+	return manager.CommandExecute(m.TaskCommand(task), nil, args...)
+}
+
+func (m *MakeManager) TaskCommand(task *task.Task) *exec.Cmd {
+	cmd := exec.Command("make", task.Name)
+	cmd.Dir = m.dir
+	return cmd
+}
+
+func (m *MakeManager) GetTitle() manager.Title {
+	return manager.Title{
+		Name:        "make",
+		Description: "parsed from " + m.filename,
+	}
+}
+
+func (m *MakeManager) GetSource() manager.Source {
+	return manager.Source{
+		Filename: m.filename,
+		Dir:      m.dir,
+	}
+}
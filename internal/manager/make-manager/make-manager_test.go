@@ -0,0 +1,101 @@
+package makemanager_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	manager "github.com/dmitriy-rs/rollercoaster/internal/manager/make-manager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMakeManager(t *testing.T) {
+	tests := []struct {
+		name             string
+		testdataDir      string
+		wantNil          bool
+		wantFilename     string
+		wantTasks        []string
+		wantDescriptions map[string]string
+	}{
+		{
+			name:         "makefile with phony and explicit targets",
+			testdataDir:  "makefile",
+			wantFilename: "Makefile",
+			wantTasks:    []string{"build", "clean", "lint", "release", "test"},
+			wantDescriptions: map[string]string{
+				"build":   "Build the application",
+				"test":    "Run tests",
+				"lint":    "",
+				"clean":   "",
+				"release": "Publish a release",
+			},
+		},
+		{
+			name:             "GNUmakefile takes precedence over Makefile",
+			testdataDir:      "gnumakefile",
+			wantFilename:     "GNUmakefile",
+			wantTasks:        []string{"all"},
+			wantDescriptions: map[string]string{"all": "Build everything"},
+		},
+		{
+			name:             "lowercase makefile",
+			testdataDir:      "lowercase-makefile",
+			wantFilename:     "makefile",
+			wantTasks:        []string{"run"},
+			wantDescriptions: map[string]string{"run": "Run the app"},
+		},
+		{
+			name:         "phony targets without rules",
+			testdataDir:  "phony-only",
+			wantFilename: "Makefile",
+			wantTasks:    []string{"install", "uninstall"},
+			wantDescriptions: map[string]string{
+				"install":   "Install binary",
+				"uninstall": "",
+			},
+		},
+		{
+			name:        "directory without makefile",
+			testdataDir: "empty",
+			wantNil:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := filepath.Join("testdata", tt.testdataDir)
+
+			mm, err := manager.ParseMakeManager(&testDir)
+			require.NoError(t, err, "ParseMakeManager() should not return error")
+
+			if tt.wantNil {
+				assert.Nil(t, mm, "ParseMakeManager() should return nil for %s", tt.testdataDir)
+				return
+			}
+			require.NotNil(t, mm, "ParseMakeManager() should return manager for %s", tt.testdataDir)
+
+			tasks, err := mm.ListTasks()
+			require.NoError(t, err, "ListTasks() should not return error")
+
+			taskNames := make([]string, len(tasks))
+			for i, task := range tasks {
+				taskNames[i] = task.Name
+				assert.Equal(t, tt.wantDescriptions[task.Name], task.Description, "Description should match for %s", task.Name)
+			}
+			assert.Equal(t, tt.wantTasks, taskNames, "Should list sorted explicit targets")
+
+			title := mm.GetTitle()
+			assert.Equal(t, "make", title.Name, "Title name should be 'make'")
+			assert.Contains(t, title.Description, tt.wantFilename, "Title should mention the parsed file")
+
+			source := mm.GetSource()
+			assert.Equal(t, filepath.Join(testDir, tt.wantFilename), source.Filename, "Source should point to the parsed file")
+			assert.Equal(t, testDir, source.Dir, "Source directory should be the makefile directory")
+
+			cmd := mm.TaskCommand(&tasks[0])
+			assert.Equal(t, []string{"make", tasks[0].Name}, cmd.Args, "Task command should run the target")
+			assert.Equal(t, testDir, cmd.Dir, "Task command should run in the makefile directory")
+		})
+	}
+}
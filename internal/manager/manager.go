@@ -2,17 +2,17 @@ package manager
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dmitriy-rs/rollercoaster/internal/logger"
 	"github.com/dmitriy-rs/rollercoaster/internal/task"
-	fuzzy "github.com/sahilm/fuzzy"
 )
 
 type Manager interface {
 	GetTitle() Title
 
 	ListTasks() ([]task.Task, error)
-	ExecuteTask(task *task.Task, args ...string)
+	ExecuteTask(task *task.Task, args ...string) error
 }
 
 type Title struct {
@@ -20,75 +20,236 @@ type Title struct {
 	Description string
 }
 
-func FindClosestTaskFromList(managers []Manager, arg string) (*ManagerTask, error) {
-	for _, manager := range managers {
-		task, _ := FindClosestTask(manager, arg)
-		if task != nil {
-			return task, nil
+// SourceManager is implemented by managers whose tasks are defined in a file
+type SourceManager interface {
+	GetSource() Source
+}
+
+type Source struct {
+	Filename string
+	Dir      string
+}
+
+// GetSource returns the file and directory the manager tasks are defined in, if known
+func GetSource(manager Manager) Source {
+	if sourceManager, ok := manager.(SourceManager); ok {
+		return sourceManager.GetSource()
+	}
+	return Source{}
+}
+
+// TaskScorer returns a bonus added to the fuzzy match score of a task, e.g. based on its run history
+type TaskScorer func(managerTask ManagerTask) int
+
+// AmbiguousMatchError is returned when the query is the exact name or alias of tasks of several managers
+// and none of them is preferred by the manager priority of the options
+type AmbiguousMatchError struct {
+	Query string
+	Tasks []ManagerTask
+}
+
+func (e *AmbiguousMatchError) Error() string {
+	return fmt.Sprintf("'%s' matches tasks of several managers", e.Query)
+}
+
+// FindClosestTaskFromList resolves the query across all managers.
+// Exact names win over exact aliases, then prefixes and then fuzzy matches. Among matches of the same kind
+// the score with the scorers bonus wins, then the preferred manager and then the closest manager.
+func FindClosestTaskFromList(managers []Manager, arg string, options *Options, scorers ...TaskScorer) (*ManagerTask, error) {
+	tasks, err := GetManagerTasksFromList(managers, options)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := rankTasks(tasks, arg, options, scorers)
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no task found for '%s'", arg)
+	}
+
+	candidates := matches
+	for i, match := range matches {
+		if match.rank != matches[0].rank {
+			candidates = matches[:i]
+			break
 		}
 	}
-	return nil, fmt.Errorf("no task found for '%s'", arg)
+
+	if candidates[0].rank >= exactAliasMatch {
+		return resolveExactMatch(tasks, arg, candidates)
+	}
+
+	// Same order as FindAllClosestTasksFromList, a small bonus doesn't outweigh a much better score
+	return matchedTask(tasks, candidates[0]), nil
 }
 
-func FindClosestTask(manager Manager, arg string) (*ManagerTask, error) {
+// resolveExactMatch returns the task of the preferred manager or an AmbiguousMatchError
+// when equally preferred managers of different kinds have the task
+func resolveExactMatch(tasks []ManagerTask, arg string, candidates []scoredMatch) (*ManagerTask, error) {
+	preferred := preferredMatch(tasks, candidates)
+	ambiguous := []ManagerTask{*matchedTask(tasks, preferred)}
+	seenManagers := map[string]bool{managerName(tasks[preferred.index]): true}
+	for _, match := range candidates {
+		name := managerName(tasks[match.index])
+		if match.priority == preferred.priority && !seenManagers[name] {
+			ambiguous = append(ambiguous, *matchedTask(tasks, match))
+			seenManagers[name] = true
+		}
+	}
+
+	if len(ambiguous) > 1 {
+		return nil, &AmbiguousMatchError{Query: arg, Tasks: ambiguous}
+	}
+	return &ambiguous[0], nil
+}
+
+// preferredMatch returns the best scored match of the most preferred manager, managers come from the closest
+func preferredMatch(tasks []ManagerTask, candidates []scoredMatch) scoredMatch {
+	preferred := candidates[0]
+	for _, match := range candidates {
+		if match.priority < preferred.priority || (match.priority == preferred.priority && match.index < preferred.index) {
+			preferred = match
+		}
+	}
+	// Candidates are sorted by score, the first one of the manager is its best
+	for _, match := range candidates {
+		if *tasks[match.index].Manager == *tasks[preferred.index].Manager {
+			return match
+		}
+	}
+	return preferred
+}
+
+// rankTasks matches the query against the tasks with the scorers bonus and the manager priority applied
+func rankTasks(tasks []ManagerTask, arg string, options *Options, scorers []TaskScorer) []scoredMatch {
+	matches := matchTasks(toTasks(tasks), arg, options.Weights())
+	for i := range matches {
+		matches[i].priority = managerPriority(*tasks[matches[i].index].Manager, options.managerPriority())
+		// Exact matches are never outweighed
+		if matches[i].rank < exactAliasMatch {
+			matches[i].bonus = scoreBonus(tasks[matches[i].index], scorers)
+		}
+	}
+	sortMatches(matches)
+
+	logger.Debug(fmt.Sprintf("Matches for '%s': %v", arg, matches))
+	return matches
+}
+
+// managerPriority returns the position of the manager in priority, versions like "npm@10" match "npm"
+func managerPriority(manager Manager, priority []string) int {
+	name := manager.GetTitle().Name
+	base, _, _ := strings.Cut(name, "@")
+	for i, preferred := range priority {
+		if preferred == name || preferred == base {
+			return i
+		}
+	}
+	return len(priority)
+}
+
+func managerName(managerTask ManagerTask) string {
+	return (*managerTask.Manager).GetTitle().Name
+}
+
+func matchedTask(tasks []ManagerTask, match scoredMatch) *ManagerTask {
+	t := tasks[match.index]
+	t.Match = match.match
+	return &t
+}
+
+func FindClosestTask(manager Manager, arg string, options *Options) (*ManagerTask, error) {
 	tasks, err := manager.ListTasks()
 	if err != nil {
 		logger.Error("Failed to list tasks", err)
 		return nil, err
 	}
 
+	tasks = customizeTasks(tasks, options)
+
 	logger.Debug(fmt.Sprintf("Found tasks: %s", tasks))
 
-	matches := fuzzy.FindFrom(arg, task.TaskSource(tasks))
+	matches := matchTasks(tasks, arg, options.Weights())
 
 	logger.Debug(fmt.Sprintf("Fuzzy matches for '%s': %v", arg, matches))
 
 	if len(matches) != 0 {
 		return &ManagerTask{
-			Task:    tasks[matches[0].Index],
+			Task:    tasks[matches[0].index],
 			Manager: &manager,
+			Match:   matches[0].match,
 		}, nil
 	}
 	return nil, fmt.Errorf("no task found for '%s'", arg)
 }
 
-func FindAllClosestTasksFromList(managers []Manager, arg string) ([]ManagerTask, error) {
-	tasks, err := GetManagerTasksFromList(managers)
+func scoreBonus(managerTask ManagerTask, scorers []TaskScorer) int {
+	bonus := 0
+	for _, scorer := range scorers {
+		bonus += scorer(managerTask)
+	}
+	return bonus
+}
+
+func FindAllClosestTasksFromList(managers []Manager, arg string, options *Options, scorers ...TaskScorer) ([]ManagerTask, error) {
+	tasks, err := GetManagerTasksFromList(managers, options)
 	if err != nil {
 		return nil, err
 	}
 
-	matches := fuzzy.FindFrom(arg, ManagerTaskSource(tasks))
-
-	logger.Debug(fmt.Sprintf("Fuzzy matches for '%s': %v", arg, matches))
+	matches := rankTasks(tasks, arg, options, scorers)
 
 	result := make([]ManagerTask, len(matches))
 	for i, match := range matches {
-		result[i] = tasks[match.Index]
+		result[i] = *matchedTask(tasks, match)
 	}
 
 	return result, nil
 }
 
-type ManagerTask struct {
-	task.Task
-	Manager *Manager
+func toTasks(managerTasks []ManagerTask) []task.Task {
+	tasks := make([]task.Task, len(managerTasks))
+	for i, t := range managerTasks {
+		tasks[i] = t.Task
+	}
+	return tasks
 }
 
-type ManagerTaskSource []ManagerTask
+// allIndexes returns the byte indexes of every rune of s
+func allIndexes(s string) []int {
+	indexes := []int{}
+	for i := range s {
+		indexes = append(indexes, i)
+	}
+	return indexes
+}
+
+// Execute runs the task with the options when its manager runs tasks as a command, otherwise the manager executes it
+func Execute(managerTask *ManagerTask, options *ExecuteOptions, args ...string) error {
+	if commandManager, ok := (*managerTask.Manager).(CommandManager); ok {
+		return CommandExecute(commandManager.TaskCommand(&managerTask.Task), options, args...)
+	}
+	return (*managerTask.Manager).ExecuteTask(&managerTask.Task, args...)
+}
 
-func (mts ManagerTaskSource) String(i int) string {
-	return mts[i].Name
+type ManagerTask struct {
+	task.Task
+	Manager *Manager
+	// Match is set for tasks found by a query
+	Match TaskMatch
 }
 
-func (mts ManagerTaskSource) Len() int {
-	return len(mts)
+// TaskMatch describes which characters of the task name or alias matched a query
+type TaskMatch struct {
+	// Alias is the matched alias, empty when the name matched
+	Alias string
+	// Indexes are the byte indexes of the matched runes, as reported by the fuzzy matcher
+	Indexes []int
 }
 
-func GetManagerTasksFromList(managers []Manager) ([]ManagerTask, error) {
+func GetManagerTasksFromList(managers []Manager, options *Options) ([]ManagerTask, error) {
 	allTasks := []ManagerTask{}
 	for _, manager := range managers {
-		tasks, err := getManagerTasks(manager)
+		tasks, err := getManagerTasks(manager, options)
 		if err != nil {
 			logger.Warning("Failed to get tasks for manager: " + manager.GetTitle().Name)
 			continue
@@ -98,11 +259,12 @@ func GetManagerTasksFromList(managers []Manager) ([]ManagerTask, error) {
 	return allTasks, nil
 }
 
-func getManagerTasks(manager Manager) ([]ManagerTask, error) {
+func getManagerTasks(manager Manager, options *Options) ([]ManagerTask, error) {
 	tasks, err := manager.ListTasks()
 	if err != nil {
 		return nil, err
 	}
+	tasks = customizeTasks(tasks, options)
 	task.SortTasks(tasks)
 
 	taskWithManager := make([]ManagerTask, len(tasks))
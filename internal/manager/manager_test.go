@@ -2,6 +2,8 @@ package manager_test
 
 import (
 	"fmt"
+	"os/exec"
+	"runtime"
 	"testing"
 
 	"github.com/dmitriy-rs/rollercoaster/internal/manager"
@@ -45,11 +47,12 @@ func (m *MockManager) ListTasks() ([]task.Task, error) {
 	return m.tasks, nil
 }
 
-func (m *MockManager) ExecuteTask(task *task.Task, args ...string) {
+func (m *MockManager) ExecuteTask(task *task.Task, args ...string) error {
 	m.executed = append(m.executed, ExecutedTask{
 		Task: task,
 		Args: args,
 	})
+	return nil
 }
 
 func (m *MockManager) SetListError(err error) {
@@ -73,7 +76,7 @@ func TestFindClosestTask_ExactMatch(t *testing.T) {
 
 	mockManager := NewMockManager("Test Manager", tasks)
 
-	result, err := manager.FindClosestTask(mockManager, "build")
+	result, err := manager.FindClosestTask(mockManager, "build", nil)
 	require.NoError(t, err, "Should not return error for exact match")
 	require.NotNil(t, result, "Should return a task for exact match")
 
@@ -110,7 +113,7 @@ func TestFindClosestTask_FuzzyMatch(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result, err := manager.FindClosestTask(mockManager, tc.input)
+			result, err := manager.FindClosestTask(mockManager, tc.input, nil)
 			require.NoError(t, err, "Should not return error for fuzzy match")
 			require.NotNil(t, result, "Should return a task for fuzzy match")
 
@@ -119,6 +122,25 @@ func TestFindClosestTask_FuzzyMatch(t *testing.T) {
 	}
 }
 
+func TestFindClosestTask_AliasMatch(t *testing.T) {
+	tasks := []task.Task{
+		{Name: "build", Description: "Build the application", Aliases: []string{"compile"}},
+		{Name: "compose", Description: "Start containers"},
+	}
+
+	mockManager := NewMockManager("Test Manager", tasks)
+
+	result, err := manager.FindClosestTask(mockManager, "compile", nil)
+	require.NoError(t, err, "Should not return error for alias match")
+	require.NotNil(t, result, "Should return a task for alias match")
+	assert.Equal(t, "build", result.Name, "Task with matching alias should be selected")
+
+	all, err := manager.FindAllClosestTasksFromList([]manager.Manager{mockManager}, "compile", nil)
+	require.NoError(t, err, "Should not return error for alias match")
+	require.NotEmpty(t, all, "Should return tasks for alias match")
+	assert.Equal(t, "build", all[0].Name, "Task with matching alias should be listed first")
+}
+
 func TestFindClosestTask_NoMatch(t *testing.T) {
 	tasks := []task.Task{
 		{Name: "build", Description: "Build the application"},
@@ -128,7 +150,7 @@ func TestFindClosestTask_NoMatch(t *testing.T) {
 
 	mockManager := NewMockManager("Test Manager", tasks)
 
-	result, err := manager.FindClosestTask(mockManager, "nonexistent")
+	result, err := manager.FindClosestTask(mockManager, "nonexistent", nil)
 	assert.Error(t, err, "Should return error for non-existent task")
 	assert.Nil(t, result, "Should return nil result for non-existent task")
 
@@ -141,7 +163,7 @@ func TestFindClosestTask_EmptyTaskList(t *testing.T) {
 
 	mockManager := NewMockManager("Empty Manager", tasks)
 
-	result, err := manager.FindClosestTask(mockManager, "anything")
+	result, err := manager.FindClosestTask(mockManager, "anything", nil)
 	assert.Error(t, err, "Should return error for empty task list")
 	assert.Nil(t, result, "Should return nil result for empty task list")
 
@@ -158,7 +180,7 @@ func TestFindClosestTask_ListTasksError(t *testing.T) {
 	expectedError := fmt.Errorf("failed to list tasks")
 	mockManager.SetListError(expectedError)
 
-	result, err := manager.FindClosestTask(mockManager, "build")
+	result, err := manager.FindClosestTask(mockManager, "build", nil)
 	assert.Error(t, err, "Should return error when ListTasks fails")
 	assert.Nil(t, result, "Should return nil result when ListTasks fails")
 	assert.Equal(t, expectedError, err, "Should return the exact error from ListTasks")
@@ -175,7 +197,7 @@ func TestFindClosestTask_MultipleMatches(t *testing.T) {
 	mockManager := NewMockManager("Test Manager", tasks)
 
 	// Should return the best match (exact or closest)
-	result, err := manager.FindClosestTask(mockManager, "test")
+	result, err := manager.FindClosestTask(mockManager, "test", nil)
 	require.NoError(t, err, "Should not return error for multiple matches")
 	require.NotNil(t, result, "Should return a task for multiple matches")
 
@@ -221,7 +243,7 @@ func TestFindClosestTask_SimilarNames(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result, err := manager.FindClosestTask(mockManager, tc.input)
+			result, err := manager.FindClosestTask(mockManager, tc.input, nil)
 			require.NoError(t, err, "Should not return error for similar names")
 			require.NotNil(t, result, "Should return a task for similar names")
 
@@ -269,7 +291,7 @@ func TestFindClosestTask_SpecialCharacters(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result, err := manager.FindClosestTask(mockManager, tc.input)
+			result, err := manager.FindClosestTask(mockManager, tc.input, nil)
 			require.NoError(t, err, "Should not return error for special characters")
 			require.NotNil(t, result, "Should return a task for special characters")
 
@@ -286,7 +308,7 @@ func TestFindClosestTask_EmptySearchString(t *testing.T) {
 
 	mockManager := NewMockManager("Test Manager", tasks)
 
-	result, err := manager.FindClosestTask(mockManager, "")
+	result, err := manager.FindClosestTask(mockManager, "", nil)
 	assert.Error(t, err, "Should return error for empty search string")
 	assert.Nil(t, result, "Should return nil result for empty search string")
 
@@ -302,14 +324,14 @@ func TestFindClosestTask_SingleTask(t *testing.T) {
 	mockManager := NewMockManager("Single Task Manager", tasks)
 
 	// Test exact match
-	result, err := manager.FindClosestTask(mockManager, "build")
+	result, err := manager.FindClosestTask(mockManager, "build", nil)
 	require.NoError(t, err, "Should not return error for exact match with single task")
 	require.NotNil(t, result, "Should return task for exact match with single task")
 
 	assert.Equal(t, "build", result.Name, "Should return the single task for exact match")
 
 	// Test fuzzy match
-	result, err = manager.FindClosestTask(mockManager, "bui")
+	result, err = manager.FindClosestTask(mockManager, "bui", nil)
 	require.NoError(t, err, "Should not return error for fuzzy match with single task")
 	require.NotNil(t, result, "Should return task for fuzzy match with single task")
 
@@ -412,7 +434,7 @@ func TestFindClosestTask_CaseSensitivity(t *testing.T) {
 	mockManager := NewMockManager("Test Manager", tasks)
 
 	// Test exact case match
-	result, err := manager.FindClosestTask(mockManager, "Build")
+	result, err := manager.FindClosestTask(mockManager, "Build", nil)
 	require.NoError(t, err, "Should not return error for exact case match")
 	require.NotNil(t, result, "Should return task for exact case match")
 
@@ -429,7 +451,7 @@ func TestFindClosestTask_VeryPoorMatch(t *testing.T) {
 	mockManager := NewMockManager("Test Manager", tasks)
 
 	// Test with a string that has very poor fuzzy match
-	result, err := manager.FindClosestTask(mockManager, "xyz123")
+	result, err := manager.FindClosestTask(mockManager, "xyz123", nil)
 	assert.Error(t, err, "Should return error for very poor match")
 	assert.Nil(t, result, "Should return nil result for very poor match")
 
@@ -449,7 +471,7 @@ func TestFindClosestTask_TaskNameSliceCreation(t *testing.T) {
 
 	// Test that we can find each task
 	for _, expectedTask := range tasks {
-		result, err := manager.FindClosestTask(mockManager, expectedTask.Name)
+		result, err := manager.FindClosestTask(mockManager, expectedTask.Name, nil)
 		require.NoError(t, err, "Should not return error for task '%s'", expectedTask.Name)
 		require.NotNil(t, result, "Should return task for '%s'", expectedTask.Name)
 
@@ -468,7 +490,7 @@ func TestFindClosestTaskFromList_SingleManager(t *testing.T) {
 	manager1 := NewMockManager("Manager1", tasks)
 	managers := []manager.Manager{manager1}
 
-	resultTask, err := manager.FindClosestTaskFromList(managers, "build")
+	resultTask, err := manager.FindClosestTaskFromList(managers, "build", nil)
 	require.NoError(t, err, "Should not return error when task is found")
 	require.NotNil(t, resultTask, "Should return the found task")
 
@@ -491,7 +513,7 @@ func TestFindClosestTaskFromList_TwoManagers_TaskInFirst(t *testing.T) {
 	manager2 := NewMockManager("Manager2", tasks2)
 	managers := []manager.Manager{manager1, manager2}
 
-	resultTask, err := manager.FindClosestTaskFromList(managers, "build")
+	resultTask, err := manager.FindClosestTaskFromList(managers, "build", nil)
 	require.NoError(t, err, "Should not return error when task is found")
 	require.NotNil(t, resultTask, "Should return the found task")
 
@@ -513,7 +535,7 @@ func TestFindClosestTaskFromList_TwoManagers_TaskInSecond(t *testing.T) {
 	manager2 := NewMockManager("Manager2", tasks2)
 	managers := []manager.Manager{manager1, manager2}
 
-	resultTask, err := manager.FindClosestTaskFromList(managers, "deploy")
+	resultTask, err := manager.FindClosestTaskFromList(managers, "deploy", nil)
 	require.NoError(t, err, "Should not return error when task is found")
 	require.NotNil(t, resultTask, "Should return the found task")
 
@@ -541,7 +563,7 @@ func TestFindClosestTaskFromList_ThreeManagers_FuzzyMatch(t *testing.T) {
 	managers := []manager.Manager{manager1, manager2, manager3}
 
 	// Test fuzzy matching - "dep" should match "deploy-staging" in the third manager
-	resultTask, err := manager.FindClosestTaskFromList(managers, "dep")
+	resultTask, err := manager.FindClosestTaskFromList(managers, "dep", nil)
 	require.NoError(t, err, "Should not return error when fuzzy match is found")
 	require.NotNil(t, resultTask, "Should return the found task")
 
@@ -569,7 +591,7 @@ func TestFindClosestTaskFromList_NoTaskFound(t *testing.T) {
 	manager3 := NewMockManager("Manager3", tasks3)
 	managers := []manager.Manager{manager1, manager2, manager3}
 
-	resultTask, err := manager.FindClosestTaskFromList(managers, "nonexistent")
+	resultTask, err := manager.FindClosestTaskFromList(managers, "nonexistent", nil)
 	assert.Error(t, err, "Should return error when no task is found in any manager")
 	assert.Nil(t, resultTask, "Should return nil task when no task is found")
 
@@ -580,7 +602,7 @@ func TestFindClosestTaskFromList_NoTaskFound(t *testing.T) {
 func TestFindClosestTaskFromList_EmptyManagersList(t *testing.T) {
 	managers := []manager.Manager{}
 
-	resultTask, err := manager.FindClosestTaskFromList(managers, "anything")
+	resultTask, err := manager.FindClosestTaskFromList(managers, "anything", nil)
 	assert.Error(t, err, "Should return error when managers list is empty")
 	assert.Nil(t, resultTask, "Should return nil task when managers list is empty")
 
@@ -599,7 +621,7 @@ func TestFindClosestTaskFromList_ManagerWithEmptyTasks(t *testing.T) {
 	manager2 := NewMockManager("Manager2", tasks2)
 	managers := []manager.Manager{manager1, manager2}
 
-	resultTask, err := manager.FindClosestTaskFromList(managers, "deploy")
+	resultTask, err := manager.FindClosestTaskFromList(managers, "deploy", nil)
 	require.NoError(t, err, "Should not return error when task is found in second manager")
 	require.NotNil(t, resultTask, "Should return the found task")
 
@@ -621,10 +643,268 @@ func TestFindClosestTaskFromList_ManagerWithListError(t *testing.T) {
 	managers := []manager.Manager{manager1, manager2}
 
 	// Even if first manager has an error, should find task in second manager
-	resultTask, err := manager.FindClosestTaskFromList(managers, "deploy")
+	resultTask, err := manager.FindClosestTaskFromList(managers, "deploy", nil)
 	require.NoError(t, err, "Should not return error when task is found in working manager")
 	require.NotNil(t, resultTask, "Should return the found task")
 
 	assert.Equal(t, "Manager2", (*resultTask.Manager).GetTitle().Name, "Should return the working manager")
 	assert.Equal(t, "deploy", resultTask.Name, "Should return the correct task")
 }
+
+func TestGetSource_ManagerWithoutSource(t *testing.T) {
+	mockManager := NewMockManager("Test Manager", nil)
+
+	assert.Equal(t, manager.Source{}, manager.GetSource(mockManager), "Managers without a source file should return an empty source")
+}
+
+func TestFindClosestTaskFromList_WithScorer(t *testing.T) {
+	first := NewMockManager("First Manager", []task.Task{
+		{Name: "tidy", Description: "Tidy modules"},
+		{Name: "test", Description: "Run tests"},
+	})
+	second := NewMockManager("Second Manager", []task.Task{
+		{Name: "typecheck", Description: "Check types"},
+	})
+	managers := []manager.Manager{first, second}
+
+	preferTypecheck := func(mt manager.ManagerTask) int {
+		if mt.Name == "typecheck" {
+			return 50
+		}
+		return 0
+	}
+
+	result, err := manager.FindClosestTaskFromList(managers, "t", nil, preferTypecheck)
+	require.NoError(t, err, "Should not return error")
+	assert.Equal(t, "typecheck", result.Name, "Task with scorer bonus should win over the closest manager")
+
+	result, err = manager.FindClosestTaskFromList(managers, "tidy", nil, preferTypecheck)
+	require.NoError(t, err, "Should not return error")
+	assert.Equal(t, "tidy", result.Name, "Exact task name should win over scorer bonus")
+
+	noBonus := func(mt manager.ManagerTask) int { return 0 }
+	result, err = manager.FindClosestTaskFromList(managers, "t", nil, noBonus)
+	require.NoError(t, err, "Should not return error")
+	assert.Equal(t, "First Manager", (*result.Manager).GetTitle().Name, "Closest manager should win without bonus")
+
+	all, err := manager.FindAllClosestTasksFromList(managers, "t", nil, preferTypecheck)
+	require.NoError(t, err, "Should not return error")
+	require.NotEmpty(t, all, "Should return matches")
+	assert.Equal(t, "typecheck", all[0].Name, "Task with scorer bonus should be listed first")
+}
+
+func TestFindAllClosestTasksFromList_MatchedIndexes(t *testing.T) {
+	mockManager := NewMockManager("Test Manager", []task.Task{
+		{Name: "build", Aliases: []string{"bd"}},
+		{Name: "bundle"},
+	})
+
+	all, err := manager.FindAllClosestTasksFromList([]manager.Manager{mockManager}, "bd", nil)
+	require.NoError(t, err, "Should not return error")
+	require.Len(t, all, 2, "Should return alias and fuzzy matches")
+
+	assert.Equal(t, "build", all[0].Name, "Task with matching alias should be listed first")
+	assert.Equal(t, manager.TaskMatch{Alias: "bd", Indexes: []int{0, 1}}, all[0].Match, "Whole alias should be matched")
+
+	assert.Equal(t, "bundle", all[1].Name, "Fuzzy matched task should be listed")
+	assert.Equal(t, manager.TaskMatch{Indexes: []int{0, 3}}, all[1].Match, "Matched letters of the name should be reported")
+}
+
+func TestFindClosestTaskFromList_MatchesAliases(t *testing.T) {
+	workspace := NewMockManager("npm", []task.Task{
+		{Name: "add", Description: "Install a dependency"},
+		{Name: "install", Description: "Install dependencies"},
+		{Name: "x", Description: "Execute a command", Aliases: []string{"npx"}},
+	})
+	managers := []manager.Manager{workspace}
+
+	for _, query := range []string{"npx", "np", "nx"} {
+		result, err := manager.FindClosestTaskFromList(managers, query, nil)
+		require.NoError(t, err, "Should not return error for '%s'", query)
+		assert.Equal(t, "x", result.Name, "Alias should be matched by '%s'", query)
+		assert.Equal(t, "npx", result.Match.Alias, "Matched alias should be reported for '%s'", query)
+	}
+}
+
+func TestFindAllClosestTasksFromList_ExactMatchWins(t *testing.T) {
+	mockManager := NewMockManager("Test Manager", []task.Task{
+		{Name: "lint:fix", Aliases: []string{"lf"}},
+		{Name: "lint", Description: "Run the linter"},
+		{Name: "format", Aliases: []string{"lint"}},
+	})
+	managers := []manager.Manager{mockManager}
+
+	all, err := manager.FindAllClosestTasksFromList(managers, "lint", nil)
+	require.NoError(t, err, "Should not return error")
+	require.Len(t, all, 3, "Should return every matching task")
+	assert.Equal(t, "lint", all[0].Name, "Exact name should be listed first")
+	assert.Equal(t, "format", all[1].Name, "Exact alias should be listed second")
+	assert.Equal(t, "lint:fix", all[2].Name, "Fuzzy match should be listed last")
+
+	preferLintFix := func(mt manager.ManagerTask) int {
+		if mt.Name == "lint:fix" {
+			return 1000
+		}
+		return 0
+	}
+	result, err := manager.FindClosestTaskFromList(managers, "lint", nil, preferLintFix)
+	require.NoError(t, err, "Should not return error")
+	assert.Equal(t, "lint", result.Name, "Exact name should win over scorer bonus")
+}
+
+func TestFindClosestTaskFromList_WeakBonus(t *testing.T) {
+	mockManager := NewMockManager("Test Manager", []task.Task{
+		{Name: "test"},
+		{Name: "toast-setup-teardown"},
+	})
+	managers := []manager.Manager{mockManager}
+	bonus := func(points int) manager.TaskScorer {
+		return func(mt manager.ManagerTask) int {
+			if mt.Name == "toast-setup-teardown" {
+				return points
+			}
+			return 0
+		}
+	}
+
+	result, err := manager.FindClosestTaskFromList(managers, "tst", nil, bonus(1))
+	require.NoError(t, err, "Should not return error")
+	assert.Equal(t, "test", result.Name, "Weak bonus should not outweigh a much better match")
+
+	all, err := manager.FindAllClosestTasksFromList(managers, "tst", nil, bonus(1))
+	require.NoError(t, err, "Should not return error")
+	assert.Equal(t, result.Name, all[0].Name, "Closest task should be the first of all matches")
+
+	result, err = manager.FindClosestTaskFromList(managers, "tst", nil, bonus(100))
+	require.NoError(t, err, "Should not return error")
+	assert.Equal(t, "toast-setup-teardown", result.Name, "Strong bonus should win")
+}
+
+func TestFindAllClosestTasksFromList_MatchWeights(t *testing.T) {
+	mockManager := NewMockManager("Test Manager", []task.Task{
+		{Name: "build", Description: "Compile the application"},
+		{Name: "generate", Aliases: []string{"codegen"}},
+	})
+	managers := []manager.Manager{mockManager}
+
+	all, err := manager.FindAllClosestTasksFromList(managers, "comp", nil)
+	require.NoError(t, err, "Should not return error")
+	assert.Empty(t, all, "Descriptions should not be matched by default")
+
+	options := &manager.Options{MatchWeights: manager.MatchWeights{Name: 1, Alias: 0.8, Description: 0.5}}
+	all, err = manager.FindAllClosestTasksFromList(managers, "comp", options)
+	require.NoError(t, err, "Should not return error")
+	require.Len(t, all, 1, "Description should be matched when weighted")
+	assert.Equal(t, "build", all[0].Name, "Task with matching description should be found")
+
+	all, err = manager.FindAllClosestTasksFromList(managers, "cg", options)
+	require.NoError(t, err, "Should not return error")
+	require.Len(t, all, 1, "Alias should be matched")
+	assert.Equal(t, "generate", all[0].Name, "Task with matching alias should be found")
+
+	options = &manager.Options{MatchWeights: manager.MatchWeights{Name: 1}}
+	all, err = manager.FindAllClosestTasksFromList(managers, "cg", options)
+	require.NoError(t, err, "Should not return error")
+	assert.Empty(t, all, "Aliases should not be fuzzy matched with a zero weight")
+}
+
+func TestFindClosestTaskFromList_ResolutionOrder(t *testing.T) {
+	taskfile := NewMockManager("task", []task.Task{
+		{Name: "build:docker", Description: "Build the image"},
+		{Name: "rebuild", Description: "Clean and build"},
+	})
+	pnpm := NewMockManager("pnpm@9", []task.Task{
+		{Name: "build", Description: "Build the app"},
+	})
+	managers := []manager.Manager{taskfile, pnpm}
+
+	result, err := manager.FindClosestTaskFromList(managers, "build", nil)
+	require.NoError(t, err, "Should not return error")
+	assert.Equal(t, "pnpm@9", (*result.Manager).GetTitle().Name, "Exact match of a later manager should win over prefix match")
+
+	result, err = manager.FindClosestTaskFromList(managers, "buil", nil)
+	require.NoError(t, err, "Should not return error")
+	assert.Equal(t, "build", result.Name, "Best scored prefix match should win over the closest manager")
+
+	result, err = manager.FindClosestTaskFromList(managers, "ebld", nil)
+	require.NoError(t, err, "Should not return error")
+	assert.Equal(t, "rebuild", result.Name, "Fuzzy match should be found without exact and prefix matches")
+}
+
+func TestFindClosestTaskFromList_Ambiguity(t *testing.T) {
+	taskfile := NewMockManager("task", []task.Task{{Name: "build"}})
+	nestedPnpm := NewMockManager("pnpm@9", []task.Task{{Name: "build"}})
+	rootPnpm := NewMockManager("pnpm@9", []task.Task{{Name: "build"}})
+	managers := []manager.Manager{taskfile, nestedPnpm, rootPnpm}
+
+	_, err := manager.FindClosestTaskFromList(managers, "build", nil)
+	var ambiguousErr *manager.AmbiguousMatchError
+	require.ErrorAs(t, err, &ambiguousErr, "Exact matches of different managers should be ambiguous")
+	require.Len(t, ambiguousErr.Tasks, 2, "Only the closest manager of every kind should be offered")
+	assert.Equal(t, "task", (*ambiguousErr.Tasks[0].Manager).GetTitle().Name)
+	assert.Same(t, nestedPnpm, *ambiguousErr.Tasks[1].Manager, "Closest pnpm manager should be offered")
+
+	options := &manager.Options{ManagerPriority: []string{"pnpm"}}
+	result, err := manager.FindClosestTaskFromList(managers, "build", options)
+	require.NoError(t, err, "Manager priority should resolve the ambiguity")
+	assert.Same(t, nestedPnpm, *result.Manager, "Closest preferred manager should win")
+
+	all, err := manager.FindAllClosestTasksFromList(managers, "build", options)
+	require.NoError(t, err, "Should not return error")
+	require.Len(t, all, 3, "Should return every exact match")
+	assert.Same(t, nestedPnpm, *all[0].Manager, "Preferred manager should be listed first")
+	assert.Same(t, taskfile, *all[2].Manager, "Other managers should be listed last")
+}
+
+func TestGetManagerTasksFromList_HiddenTasksAndAliases(t *testing.T) {
+	tasks := []task.Task{
+		{Name: "build", Aliases: []string{"b"}},
+		{Name: "internal:setup"},
+		{Name: "test:unit"},
+	}
+	mockManager := NewMockManager("Test Manager", tasks)
+
+	options := &manager.Options{
+		HiddenTasks: []string{"internal:*"},
+		Aliases:     map[string]string{"tu": "test:unit", "bb": "build", "missing": "deploy"},
+	}
+
+	result, err := manager.GetManagerTasksFromList([]manager.Manager{mockManager}, options)
+	require.NoError(t, err, "Should not return error")
+	require.Len(t, result, 2, "Hidden tasks should be excluded")
+	assert.Equal(t, []string{"b", "bb"}, result[0].Aliases, "Configured alias should be added")
+	assert.Equal(t, []string{"tu"}, result[1].Aliases, "Configured alias should be added")
+	assert.Equal(t, []string{"b"}, tasks[0].Aliases, "Tasks of the manager should not be modified")
+
+	found, err := manager.FindClosestTaskFromList([]manager.Manager{mockManager}, "tu", options)
+	require.NoError(t, err, "Should not return error")
+	assert.Equal(t, "test:unit", found.Name, "Configured alias should be matched")
+
+	_, err = manager.FindClosestTaskFromList([]manager.Manager{mockManager}, "internal:setup", options)
+	assert.Error(t, err, "Hidden task should not be matched")
+}
+
+// commandMockManager runs its tasks as a command failing when it is executed
+type commandMockManager struct {
+	*MockManager
+}
+
+func (m *commandMockManager) TaskCommand(task *task.Task) *exec.Cmd {
+	return exec.Command("sh", "-c", "exit 1", task.Name)
+}
+
+func TestExecute(t *testing.T) {
+	mockManager := NewMockManager("Test Manager", nil)
+	var plainManager manager.Manager = mockManager
+	err := manager.Execute(&manager.ManagerTask{Task: task.Task{Name: "build"}, Manager: &plainManager}, nil, "--watch")
+	require.NoError(t, err, "Should not return error")
+	require.Len(t, mockManager.GetExecutedTasks(), 1, "Manager without commands should execute the task")
+	assert.Equal(t, []string{"--watch"}, mockManager.GetExecutedTasks()[0].Args)
+
+	var commandManager manager.Manager = &commandMockManager{NewMockManager("Command Manager", nil)}
+	managerTask := &manager.ManagerTask{Task: task.Task{Name: "build"}, Manager: &commandManager}
+	assert.NoError(t, manager.Execute(managerTask, &manager.ExecuteOptions{DryRun: true}), "Dry run should not execute the command")
+	if runtime.GOOS != "windows" {
+		assert.Error(t, manager.Execute(managerTask, nil), "Command should be executed without options")
+	}
+}
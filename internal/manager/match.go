@@ -0,0 +1,132 @@
+package manager
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+	fuzzy "github.com/sahilm/fuzzy"
+)
+
+// MatchWeights are multipliers of the fuzzy score of each task field, a zero weight disables matching the field
+type MatchWeights struct {
+	Name        float64
+	Alias       float64
+	Description float64
+}
+
+var DefaultMatchWeights = MatchWeights{Name: 1, Alias: 0.8, Description: 0}
+
+// Kinds of matches from the worst to the best, a better kind always wins regardless of the score
+const (
+	fuzzyMatch = iota
+	prefixMatch
+	exactAliasMatch
+	exactNameMatch
+)
+
+type scoredMatch struct {
+	index int
+	rank  int
+	score float64
+	// bonus is added to the score by task scorers
+	bonus int
+	// priority of the task manager, lower is preferred
+	priority int
+	match    TaskMatch
+}
+
+func (m scoredMatch) total() float64 {
+	return m.score + float64(m.bonus)
+}
+
+// matchTasks matches the query against the name, aliases and description of every task.
+// A task is scored by its best weighted field, the matches are sorted from the best to the worst:
+// exact names, exact aliases, prefixes and then fuzzy matches.
+func matchTasks(tasks []task.Task, query string, weights MatchWeights) []scoredMatch {
+	best := map[int]scoredMatch{}
+	consider := func(m scoredMatch) {
+		current, ok := best[m.index]
+		if !ok || m.rank > current.rank || (m.rank == current.rank && m.score > current.score) {
+			best[m.index] = m
+		}
+	}
+
+	var aliases []string
+	var aliasTasks []int
+	for i, t := range tasks {
+		if t.Name == query {
+			consider(scoredMatch{index: i, rank: exactNameMatch, match: TaskMatch{Indexes: allIndexes(query)}})
+		}
+		for _, alias := range t.Aliases {
+			if alias == query {
+				consider(scoredMatch{index: i, rank: exactAliasMatch, match: TaskMatch{Alias: alias, Indexes: allIndexes(query)}})
+			}
+			aliases = append(aliases, alias)
+			aliasTasks = append(aliasTasks, i)
+		}
+	}
+
+	if weights.Name > 0 {
+		for _, m := range fuzzy.FindFrom(query, task.TaskSource(tasks)) {
+			consider(scoredMatch{index: m.Index, rank: fieldRank(m.Str, query), score: weigh(m.Score, weights.Name), match: TaskMatch{Indexes: m.MatchedIndexes}})
+		}
+	}
+	if weights.Alias > 0 {
+		for _, m := range fuzzy.Find(query, aliases) {
+			consider(scoredMatch{index: aliasTasks[m.Index], rank: fieldRank(m.Str, query), score: weigh(m.Score, weights.Alias), match: TaskMatch{Alias: m.Str, Indexes: m.MatchedIndexes}})
+		}
+	}
+	if weights.Description > 0 {
+		for _, m := range fuzzy.FindFrom(query, descriptionSource(tasks)) {
+			consider(scoredMatch{index: m.Index, rank: fuzzyMatch, score: weigh(m.Score, weights.Description)})
+		}
+	}
+
+	matches := make([]scoredMatch, 0, len(best))
+	for _, m := range best {
+		matches = append(matches, m)
+	}
+	sortMatches(matches)
+	return matches
+}
+
+func fieldRank(field string, query string) int {
+	if strings.HasPrefix(field, query) {
+		return prefixMatch
+	}
+	return fuzzyMatch
+}
+
+// sortMatches sorts by the kind of match, then by score and manager priority, keeping the tasks order otherwise
+func sortMatches(matches []scoredMatch) {
+	slices.SortFunc(matches, func(a, b scoredMatch) int {
+		if a.rank != b.rank {
+			return b.rank - a.rank
+		}
+		if a.total() != b.total() {
+			if a.total() > b.total() {
+				return -1
+			}
+			return 1
+		}
+		if a.priority != b.priority {
+			return a.priority - b.priority
+		}
+		return a.index - b.index
+	})
+}
+
+// weigh applies the weight to a fuzzy score, a lower weight always lowers the score even when it is negative
+func weigh(score int, weight float64) float64 {
+	if score < 0 {
+		return float64(score) / weight
+	}
+	return float64(score) * weight
+}
+
+type descriptionSource []task.Task
+
+func (ds descriptionSource) String(i int) string { return ds[i].Description }
+
+func (ds descriptionSource) Len() int { return len(ds) }
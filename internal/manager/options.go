@@ -0,0 +1,59 @@
+package manager
+
+// Options customize listing and matching tasks, nil options list every task and match with DefaultMatchWeights
+type Options struct {
+	// HiddenTasks are glob patterns of task names (e.g. "internal:*") excluded from listing and matching
+	HiddenTasks []string
+	// Aliases maps additional aliases to task names, every task with the name gets the alias
+	Aliases map[string]string
+	// MatchWeights weigh the fuzzy score of every task field, zero weights fall back to DefaultMatchWeights
+	MatchWeights MatchWeights
+	// ManagerPriority lists names of preferred managers, e.g. "pnpm" or "task", when tasks of several managers match a query equally well
+	ManagerPriority []string
+}
+
+// Weights returns the weights queries are matched with
+func (o *Options) Weights() MatchWeights {
+	if o == nil || o.MatchWeights == (MatchWeights{}) {
+		return DefaultMatchWeights
+	}
+	return o.MatchWeights
+}
+
+func (o *Options) hiddenTasks() []string {
+	if o == nil {
+		return nil
+	}
+	return o.HiddenTasks
+}
+
+func (o *Options) aliases() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.Aliases
+}
+
+func (o *Options) managerPriority() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ManagerPriority
+}
+
+// ExecuteOptions change how commands are executed, nil options run commands in their directory
+type ExecuteOptions struct {
+	// RunInCurrentDir runs commands in the invoking directory instead of cmd.Dir
+	RunInCurrentDir bool
+	// DryRun prints the resolved command instead of running it
+	DryRun bool
+}
+
+func (o *ExecuteOptions) runInCurrentDir() bool {
+	return o != nil && o.RunInCurrentDir
+}
+
+// IsDryRun reports whether commands are printed instead of executed
+func (o *ExecuteOptions) IsDryRun() bool {
+	return o != nil && o.DryRun
+}
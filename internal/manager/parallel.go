@@ -0,0 +1,119 @@
+package manager
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
+)
+
+// CommandManager is implemented by managers running every task as a single command, so the tasks can run in parallel and with ExecuteOptions
+type CommandManager interface {
+	TaskCommand(task *task.Task) *exec.Cmd
+}
+
+// ParallelCommand is a command run by CommandExecuteParallel, every line of its output is prefixed with the label
+type ParallelCommand struct {
+	Label string
+	Cmd   *exec.Cmd
+}
+
+type ParallelResult struct {
+	Duration time.Duration
+	Err      error
+}
+
+var labelColors = []string{"39", "212", "208", "42", "141", "220"}
+
+// CommandExecuteParallel runs the commands concurrently and waits for all of them.
+// Output lines of every command are prefixed with its colored label, the commands don't read stdin.
+// The results are in the order of the commands.
+func CommandExecuteParallel(commands []ParallelCommand, options *ExecuteOptions, stdout io.Writer, stderr io.Writer) []ParallelResult {
+	results := make([]ParallelResult, len(commands))
+
+	labelWidth := 0
+	for _, command := range commands {
+		labelWidth = max(labelWidth, lipgloss.Width(command.Label))
+	}
+
+	// Shared by all writers, so lines of different commands never mix
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i, command := range commands {
+		cmd := command.Cmd
+		if options.runInCurrentDir() {
+			cmd.Dir = ""
+		}
+		if options.IsDryRun() {
+			results[i].Err = PrintCommand(stdout, cmd)
+			continue
+		}
+
+		label := fmt.Sprintf("%-*s |", labelWidth, command.Label)
+		prefix := lipgloss.NewStyle().Foreground(lipgloss.Color(labelColors[i%len(labelColors)])).Render(label) + " "
+		out := &prefixWriter{w: stdout, prefix: prefix, mu: &mu}
+		errOut := &prefixWriter{w: stderr, prefix: prefix, mu: &mu}
+		cmd.Stdout = out
+		cmd.Stderr = errOut
+
+		commandLine := logExecution(cmd)
+		start := time.Now()
+		if err := cmd.Start(); err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := cmd.Wait()
+			out.Flush()
+			errOut.Flush()
+			results[i] = ParallelResult{Duration: time.Since(start), Err: commandError(commandLine, err)}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// prefixWriter writes complete lines prefixed with prefix, an incomplete line is kept until it ends or Flush is called
+type prefixWriter struct {
+	w      io.Writer
+	prefix string
+	mu     *sync.Mutex
+	buf    []byte
+}
+
+func (pw *prefixWriter) Write(p []byte) (int, error) {
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+
+	pw.buf = append(pw.buf, p...)
+	for {
+		end := bytes.IndexByte(pw.buf, '\n')
+		if end == -1 {
+			break
+		}
+		if _, err := fmt.Fprintf(pw.w, "%s%s", pw.prefix, pw.buf[:end+1]); err != nil {
+			return 0, err
+		}
+		pw.buf = pw.buf[end+1:]
+	}
+	return len(p), nil
+}
+
+// Flush writes the remaining incomplete line
+func (pw *prefixWriter) Flush() {
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+
+	if len(pw.buf) > 0 {
+		_, _ = fmt.Fprintf(pw.w, "%s%s\n", pw.prefix, pw.buf)
+		pw.buf = nil
+	}
+}
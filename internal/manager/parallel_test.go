@@ -0,0 +1,51 @@
+package manager_test
+
+import (
+	"bytes"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/manager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandExecuteParallel(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Requires sh")
+	}
+
+	var stdout, stderr bytes.Buffer
+	results := manager.CommandExecuteParallel([]manager.ParallelCommand{
+		{Label: "api", Cmd: exec.Command("sh", "-c", "echo first; echo second")},
+		{Label: "web:dev", Cmd: exec.Command("sh", "-c", "printf 'no newline'; echo failed >&2; exit 3")},
+	}, nil, &stdout, &stderr)
+
+	require.Len(t, results, 2, "Should return a result for every command")
+	assert.NoError(t, results[0].Err, "Successful command should not return error")
+
+	var exitErr *manager.ExitError
+	require.ErrorAs(t, results[1].Err, &exitErr, "Failed command should return ExitError")
+	assert.Equal(t, 3, exitErr.Code, "Exit code should be reported")
+
+	stdoutLines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	assert.ElementsMatch(t, []string{
+		"api     | first",
+		"api     | second",
+		"web:dev | no newline",
+	}, stdoutLines, "Every line should be prefixed with the padded label")
+	assert.Equal(t, "web:dev | failed\n", stderr.String(), "Stderr should be prefixed as well")
+}
+
+func TestCommandExecuteParallel_DryRun(t *testing.T) {
+	var stdout bytes.Buffer
+	cmd := exec.Command("sh", "-c", "exit 1")
+	cmd.Dir = "/tmp"
+	results := manager.CommandExecuteParallel([]manager.ParallelCommand{{Label: "fail", Cmd: cmd}}, &manager.ExecuteOptions{DryRun: true}, &stdout, &stdout)
+
+	require.Len(t, results, 1)
+	assert.NoError(t, results[0].Err, "Dry run should not execute the command")
+	assert.Equal(t, "command: sh -c 'exit 1'\ndir: /tmp\n", stdout.String())
+}
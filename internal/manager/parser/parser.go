@@ -1,14 +1,14 @@
 package parser
 
 import (
-	"os"
-	"path/filepath"
 	"slices"
 
 	"github.com/dmitriy-rs/rollercoaster/internal/logger"
 	"github.com/dmitriy-rs/rollercoaster/internal/manager"
 	configfile "github.com/dmitriy-rs/rollercoaster/internal/manager/config-file"
 	jsmanager "github.com/dmitriy-rs/rollercoaster/internal/manager/js"
+	justmanager "github.com/dmitriy-rs/rollercoaster/internal/manager/just-manager"
+	makemanager "github.com/dmitriy-rs/rollercoaster/internal/manager/make-manager"
 	taskmanager "github.com/dmitriy-rs/rollercoaster/internal/manager/task-manager"
 )
 
@@ -21,7 +21,7 @@ func ParseManager(dir *string, config *ParseManagerConfig) ([]manager.Manager, e
 
 	parseConfig := configfile.ParseConfig{
 		CurrentDir: *dir,
-		RootDir:    findClosestGitDir(dir),
+		RootDir:    configfile.FindClosestGitDir(dir),
 	}
 
 	jsWorkspace, err := jsmanager.ParseJsWorkspace(&parseConfig.RootDir, config.DefaultJSManager)
@@ -40,18 +40,46 @@ func ParseManager(dir *string, config *ParseManagerConfig) ([]manager.Manager, e
 			}
 		}
 
+		denoManager, err := jsmanager.ParseDenoManager(&dir)
+		if err != nil {
+			logger.Warning(err.Error())
+		} else if denoManager != nil {
+			managers = append(managers, denoManager)
+		}
+
 		manager, err := taskmanager.ParseTaskManager(&dir)
 		if err != nil {
 			logger.Warning(err.Error())
 		} else if manager != nil {
 			managers = append(managers, manager)
 		}
+
+		makeManager, err := makemanager.ParseMakeManager(&dir)
+		if err != nil {
+			logger.Warning(err.Error())
+		} else if makeManager != nil {
+			managers = append(managers, makeManager)
+		}
+
+		justManager, err := justmanager.ParseJustManager(&dir)
+		if err != nil {
+			logger.Warning(err.Error())
+		} else if justManager != nil {
+			managers = append(managers, justManager)
+		}
 	}
 
 	if len(managers) > 0 || jsWorkspace != nil {
 		slices.Reverse(managers)
 
 		if jsWorkspace != nil {
+			monorepoManager, err := jsmanager.ParseJsMonorepoManager(&parseConfig.RootDir, jsWorkspace)
+			if err != nil {
+				logger.Warning(err.Error())
+			} else if monorepoManager != nil {
+				managers = append(managers, monorepoManager)
+			}
+
 			managers = append(managers, &jsmanager.JsWorkspaceManager{
 				Workspace: jsWorkspace,
 			})
@@ -63,23 +91,3 @@ func ParseManager(dir *string, config *ParseManagerConfig) ([]manager.Manager, e
 	logger.Warning("Could not find a task manager in the current directory or its parents")
 	return nil, nil
 }
-
-func findClosestGitDir(dir *string) string {
-	if dir == nil || *dir == "" {
-		return ""
-	}
-	currentDir := *dir
-	for {
-		gitPath := filepath.Join(currentDir, ".git")
-		info, err := os.Stat(gitPath)
-		if err == nil && info.IsDir() {
-			return currentDir
-		}
-		parentDir := filepath.Dir(currentDir)
-		if parentDir == currentDir {
-			break
-		}
-		currentDir = parentDir
-	}
-	return *dir
-}
@@ -52,7 +52,7 @@ func TestParseManager(t *testing.T) {
 			name:                 "nested from parent directory - package.json with taskfile",
 			testdataDir:          "nested-package-json",
 			createGitDir:         true,
-			expectedManagers:     3, // parent task + parent js + workspace manager
+			expectedManagers:     4, // parent task + parent js + workspace packages + workspace manager
 			expectedJsManager:    true,
 			expectedTaskMgr:      true,
 			expectedWorkspaceMgr: true,
@@ -61,7 +61,7 @@ func TestParseManager(t *testing.T) {
 			name:                 "nested from child directory - package.json only in child",
 			testdataDir:          "nested-package-json/subdir",
 			createGitDir:         true,
-			expectedManagers:     4, // parent task + parent js + child js + workspace manager
+			expectedManagers:     5, // parent task + parent js + child js + workspace packages + workspace manager
 			expectedJsManager:    true,
 			expectedTaskMgr:      true,
 			expectedWorkspaceMgr: true,
@@ -219,7 +219,7 @@ func TestFindClosestGitDir(t *testing.T) {
 				defer os.RemoveAll(gitDir) //nolint:errcheck
 			}
 
-			// We need to test the findClosestGitDir function indirectly through ParseManager
+			// We need to test the FindClosestGitDir function indirectly through ParseManager
 			// since it's not exported
 			config := &parser.ParseManagerConfig{
 				DefaultJSManager: "",
@@ -263,8 +263,8 @@ func TestParseManagerJsWorkspaceAlwaysRegistered(t *testing.T) {
 			name:                 "nested with workspace at root",
 			testdataDir:          "nested-package-json",
 			expectedWorkspaceMgr: true,
-			expectedJsManagers:   1, // one js manager for the package.json
-			expectedTotalMgrs:    3, // task manager + js manager + workspace manager
+			expectedJsManagers:   2, // one js manager for the package.json + one for workspace packages
+			expectedTotalMgrs:    4, // task manager + js manager + workspace packages + workspace manager
 		},
 		{
 			name:                 "no js workspace",
@@ -338,3 +338,132 @@ func TestParseManagerJsWorkspaceAlwaysRegistered(t *testing.T) {
 		})
 	}
 }
+
+func TestParseManagerMakefile(t *testing.T) {
+	tests := []struct {
+		name                 string
+		testdataDir          string
+		expectedManagerNames []string
+	}{
+		{
+			name:                 "makefile next to taskfile",
+			testdataDir:          "makefile-and-taskfile",
+			expectedManagerNames: []string{"make", "task"},
+		},
+		{
+			name:                 "nested makefile",
+			testdataDir:          "makefile-and-taskfile/subdir",
+			expectedManagerNames: []string{"make", "make", "task"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := filepath.Join("testdata", tt.testdataDir)
+
+			gitDir := filepath.Join("testdata", "makefile-and-taskfile", ".git")
+			err := os.MkdirAll(gitDir, 0755)
+			require.NoError(t, err, "Failed to create .git directory")
+			defer os.RemoveAll(gitDir) //nolint:errcheck
+
+			config := &parser.ParseManagerConfig{
+				DefaultJSManager: "",
+			}
+			managers, err := parser.ParseManager(&testDir, config)
+			require.NoError(t, err, "ParseManager should not return error")
+
+			managerNames := make([]string, len(managers))
+			for i, manager := range managers {
+				managerNames[i] = manager.GetTitle().Name
+			}
+			assert.Equal(t, tt.expectedManagerNames, managerNames, "Should list make managers closest first")
+		})
+	}
+}
+
+func TestParseManagerJustfile(t *testing.T) {
+	tests := []struct {
+		name                 string
+		testdataDir          string
+		expectedManagerNames []string
+	}{
+		{
+			name:                 "justfile at root",
+			testdataDir:          "nested-justfile",
+			expectedManagerNames: []string{"just"},
+		},
+		{
+			name:                 "nested justfile",
+			testdataDir:          "nested-justfile/subdir",
+			expectedManagerNames: []string{"just", "just"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDir := filepath.Join("testdata", tt.testdataDir)
+
+			gitDir := filepath.Join("testdata", "nested-justfile", ".git")
+			err := os.MkdirAll(gitDir, 0755)
+			require.NoError(t, err, "Failed to create .git directory")
+			defer os.RemoveAll(gitDir) //nolint:errcheck
+
+			config := &parser.ParseManagerConfig{
+				DefaultJSManager: "",
+			}
+			managers, err := parser.ParseManager(&testDir, config)
+			require.NoError(t, err, "ParseManager should not return error")
+
+			managerNames := make([]string, len(managers))
+			for i, manager := range managers {
+				managerNames[i] = manager.GetTitle().Name
+			}
+			assert.Equal(t, tt.expectedManagerNames, managerNames, "Should list just managers closest first")
+		})
+	}
+}
+
+func TestParseManagerDeno(t *testing.T) {
+	testDir := filepath.Join("testdata", "deno-only")
+
+	gitDir := filepath.Join(testDir, ".git")
+	err := os.MkdirAll(gitDir, 0755)
+	require.NoError(t, err, "Failed to create .git directory")
+	defer os.RemoveAll(gitDir) //nolint:errcheck
+
+	config := &parser.ParseManagerConfig{
+		DefaultJSManager: "",
+	}
+	managers, err := parser.ParseManager(&testDir, config)
+	require.NoError(t, err, "ParseManager should not return error")
+	require.Len(t, managers, 2, "Should have deno task manager and deno workspace manager")
+
+	assert.Equal(t, "deno", managers[0].GetTitle().Name, "Deno tasks should come first")
+	assert.Contains(t, managers[0].GetTitle().Description, "deno.json", "Deno tasks should be parsed from deno.json")
+	assert.Equal(t, "deno", managers[1].GetTitle().Name, "Deno workspace should be registered")
+	assert.Contains(t, managers[1].GetTitle().Description, "commands", "Workspace manager should expose package commands")
+}
+
+func TestParseManagerJsMonorepo(t *testing.T) {
+	testDir := filepath.Join("testdata", "pnpm-monorepo", "packages", "web")
+
+	gitDir := filepath.Join("testdata", "pnpm-monorepo", ".git")
+	err := os.MkdirAll(gitDir, 0755)
+	require.NoError(t, err, "Failed to create .git directory")
+	defer os.RemoveAll(gitDir) //nolint:errcheck
+
+	config := &parser.ParseManagerConfig{
+		DefaultJSManager: "",
+	}
+	managers, err := parser.ParseManager(&testDir, config)
+	require.NoError(t, err, "ParseManager should not return error")
+	require.Len(t, managers, 4, "Should have package js, root js, monorepo and workspace managers")
+
+	monorepoTitle := managers[2].GetTitle()
+	assert.Contains(t, monorepoTitle.Description, "pnpm-workspace.yaml", "Monorepo manager should be parsed from pnpm-workspace.yaml")
+
+	tasks, err := managers[2].ListTasks()
+	require.NoError(t, err, "Monorepo manager should list tasks")
+	require.Len(t, tasks, 1, "Should list scripts of workspace packages")
+	assert.Equal(t, "@app/web#dev", tasks[0].Name, "Workspace package script should be qualified by package name")
+}
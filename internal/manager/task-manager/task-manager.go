@@ -2,26 +2,131 @@ package taskmanager
 
 import (
 	"errors"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
 	"strings"
 
+	"github.com/dmitriy-rs/rollercoaster/internal/logger"
 	"github.com/dmitriy-rs/rollercoaster/internal/manager"
 	config "github.com/dmitriy-rs/rollercoaster/internal/manager/config-file"
 	"github.com/dmitriy-rs/rollercoaster/internal/task"
 )
 
 type TaskManager struct {
-	config    *TaskManagerConfig
-	filenames []string
+	config        *TaskManagerConfig
+	filenames     []string
+	includedTasks []task.Task
+	dir           string
 }
 
-type taskMap = map[string]struct {
-	Description string `yaml:"desc"`
+type taskMap = map[string]taskfileTask
+
+type taskfileTask struct {
+	Description string   `yaml:"desc"`
+	Summary     string   `yaml:"summary"`
+	Aliases     []string `yaml:"aliases"`
+	Internal    bool     `yaml:"internal"`
+	Prompt      prompts  `yaml:"prompt"`
+	Platforms   []string `yaml:"platforms"`
+}
+
+// prompts supports both a single prompt string and a list of prompts
+type prompts []string
+
+func (p *prompts) UnmarshalYAML(unmarshal func(any) error) error {
+	var prompt string
+	if err := unmarshal(&prompt); err == nil {
+		*p = prompts{prompt}
+		return nil
+	}
+
+	var list []string
+	if err := unmarshal(&list); err != nil {
+		return err
+	}
+	*p = list
+	return nil
 }
 
+// visible reports whether the task is shown by `task --list` on the current platform
+func (t taskfileTask) visible() bool {
+	if t.Internal {
+		return false
+	}
+	if len(t.Platforms) == 0 {
+		return true
+	}
+	for _, platform := range t.Platforms {
+		if matchesPlatform(platform, runtime.GOOS, runtime.GOARCH) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesPlatform checks a Taskfile platform which is either an OS, an architecture or "os/arch"
+func matchesPlatform(platform, goos, goarch string) bool {
+	if platformOS, platformArch, ok := strings.Cut(platform, "/"); ok {
+		return platformOS == goos && platformArch == goarch
+	}
+	return platform == goos || platform == goarch
+}
+
+func (t taskfileTask) toTask(name string, namePrefix string, aliasPrefixes []string) task.Task {
+	var aliases []string
+	for _, alias := range t.Aliases {
+		aliases = append(aliases, namePrefix+alias)
+	}
+	for _, aliasPrefix := range aliasPrefixes {
+		aliases = append(aliases, aliasPrefix+name)
+		for _, alias := range t.Aliases {
+			aliases = append(aliases, aliasPrefix+alias)
+		}
+	}
+	return task.Task{
+		Name:        namePrefix + name,
+		Description: t.Description,
+		Summary:     strings.TrimSpace(t.Summary),
+		Aliases:     aliases,
+		Prompts:     t.Prompt,
+	}
+}
+
+type includeMap = map[string]taskfileInclude
+
 type TaskManagerConfig struct {
-	Version string  `yaml:"version"`
-	Tasks   taskMap `yaml:"tasks"`
+	Version  string     `yaml:"version"`
+	Tasks    taskMap    `yaml:"tasks"`
+	Includes includeMap `yaml:"includes"`
+}
+
+type taskfileInclude struct {
+	Taskfile string   `yaml:"taskfile"`
+	Dir      string   `yaml:"dir"` // working directory of included tasks, handled by `task` itself
+	Optional bool     `yaml:"optional"`
+	Internal bool     `yaml:"internal"`
+	Flatten  bool     `yaml:"flatten"`
+	Aliases  []string `yaml:"aliases"`
+}
+
+// UnmarshalYAML supports the short `namespace: ./path` include form
+func (i *taskfileInclude) UnmarshalYAML(unmarshal func(any) error) error {
+	var taskfile string
+	if err := unmarshal(&taskfile); err == nil {
+		*i = taskfileInclude{Taskfile: taskfile}
+		return nil
+	}
+
+	type taskfileIncludeObject taskfileInclude
+	var include taskfileIncludeObject
+	if err := unmarshal(&include); err != nil {
+		return err
+	}
+	*i = taskfileInclude(include)
+	return nil
 }
 
 var localTaskFilenames = [4]string{
@@ -38,7 +143,7 @@ var distTaskFilenames = [4]string{
 }
 
 func ParseTaskManager(dir *string) (*TaskManager, error) {
-	tm := &TaskManager{}
+	tm := &TaskManager{dir: *dir}
 
 	localFile := config.FindFirstInDirectory(dir, localTaskFilenames[:])
 	distFile := config.FindFirstInDirectory(dir, distTaskFilenames[:])
@@ -60,18 +165,140 @@ func ParseTaskManager(dir *string) (*TaskManager, error) {
 		if tm.config == nil {
 			tm.config = config
 		} else {
+			if tm.config.Tasks == nil {
+				tm.config.Tasks = taskMap{}
+			}
 			for taskName, task := range config.Tasks {
 				tm.config.Tasks[taskName] = task
 			}
+			if tm.config.Includes == nil {
+				tm.config.Includes = includeMap{}
+			}
+			for namespace, include := range config.Includes {
+				tm.config.Includes[namespace] = include
+			}
 		}
 		tm.filenames = append(tm.filenames, localFile.Filename)
 	}
 	if tm.config == nil {
 		return nil, nil
 	}
+
+	visited := map[string]bool{}
+	for _, filename := range tm.filenames {
+		if absFilename, err := filepath.Abs(filename); err == nil {
+			visited[absFilename] = true
+		}
+	}
+	includedTasks, err := parseIncludes(tm.config.Includes, *dir, "", nil, visited)
+	if err != nil {
+		return nil, err
+	}
+	tm.includedTasks = includedTasks
+
 	return tm, nil
 }
 
+// parseIncludes recursively loads included Taskfiles relative to the including Taskfile directory
+// and returns their tasks under "namespace:task" names like `task --list` does
+func parseIncludes(includes includeMap, dir string, prefix string, aliasPrefixes []string, visited map[string]bool) ([]task.Task, error) {
+	namespaces := make([]string, 0, len(includes))
+	for namespace := range includes {
+		namespaces = append(namespaces, namespace)
+	}
+	slices.Sort(namespaces)
+
+	tasks := []task.Task{}
+	for _, namespace := range namespaces {
+		include := includes[namespace]
+		if include.Internal {
+			continue
+		}
+
+		file, err := findIncludedTaskfile(dir, include.Taskfile)
+		if err != nil {
+			logger.Warning("Skipping Taskfile include '" + namespace + "': " + err.Error())
+			continue
+		}
+		if file == nil {
+			// Templated and remote includes are resolved by task itself, the rest of the tasks are still listed
+			if !include.Optional {
+				logger.Warning("Skipping Taskfile include '" + namespace + "', Taskfile not found: " + include.Taskfile)
+			}
+			continue
+		}
+
+		absFilename, err := filepath.Abs(file.Filename)
+		if err != nil {
+			return nil, err
+		}
+		if visited[absFilename] {
+			return nil, errors.New("Taskfile include cycle detected: " + file.Filename)
+		}
+
+		config, err := parseConfig(file)
+		if err != nil {
+			logger.Warning("Skipping Taskfile include '" + namespace + "': " + err.Error())
+			continue
+		}
+
+		namespacePrefix := prefix + namespace + ":"
+		namespaceAliasPrefixes := []string{}
+		for _, parentPrefix := range append([]string{prefix}, aliasPrefixes...) {
+			if parentPrefix != prefix {
+				namespaceAliasPrefixes = append(namespaceAliasPrefixes, parentPrefix+namespace+":")
+			}
+			for _, alias := range include.Aliases {
+				namespaceAliasPrefixes = append(namespaceAliasPrefixes, parentPrefix+alias+":")
+			}
+		}
+		if include.Flatten {
+			namespacePrefix = prefix
+			namespaceAliasPrefixes = aliasPrefixes
+		}
+
+		for name, taskInfo := range config.Tasks {
+			if taskInfo.visible() {
+				tasks = append(tasks, taskInfo.toTask(name, namespacePrefix, namespaceAliasPrefixes))
+			}
+		}
+
+		visited[absFilename] = true
+		nestedTasks, err := parseIncludes(config.Includes, filepath.Dir(file.Filename), namespacePrefix, namespaceAliasPrefixes, visited)
+		delete(visited, absFilename)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, nestedTasks...)
+	}
+	return tasks, nil
+}
+
+// findIncludedTaskfile resolves an include path which can point to a Taskfile or a directory containing one
+func findIncludedTaskfile(dir string, taskfilePath string) (*config.ConfigFile, error) {
+	if strings.HasPrefix(taskfilePath, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		taskfilePath = filepath.Join(home, taskfilePath[2:])
+	}
+	if !filepath.IsAbs(taskfilePath) {
+		taskfilePath = filepath.Join(dir, taskfilePath)
+	}
+
+	info, err := os.Stat(taskfilePath)
+	if err != nil {
+		return nil, nil
+	}
+	if info.IsDir() {
+		return config.FindFirstInDirectory(&taskfilePath, append(localTaskFilenames[:], distTaskFilenames[:]...)), nil
+	}
+
+	taskfileDir, filename := filepath.Split(taskfilePath)
+	return config.FindInDirectory(&taskfileDir, filename), nil
+}
+
 func parseConfig(file *config.ConfigFile) (*TaskManagerConfig, error) {
 	config, err := config.ParseFileAsYaml[TaskManagerConfig](file)
 	if err != nil {
@@ -90,23 +317,28 @@ func parseConfig(file *config.ConfigFile) (*TaskManagerConfig, error) {
 }
 
 func (tm *TaskManager) ListTasks() ([]task.Task, error) {
-	if tm.config.Tasks == nil {
+	if tm.config.Tasks == nil && len(tm.includedTasks) == 0 {
 		return nil, nil
 	}
 	tasks := []task.Task{}
 	for name, taskInfo := range tm.config.Tasks {
-		tasks = append(tasks, task.Task{
-			Name:        name,
-			Description: taskInfo.Description,
-		})
+		if taskInfo.visible() {
+			tasks = append(tasks, taskInfo.toTask(name, "", nil))
+		}
 	}
+	tasks = append(tasks, tm.includedTasks...)
 	task.SortTasks(tasks)
 	return tasks, nil
 }
 
-func (tm *TaskManager) ExecuteTask(task *task.Task, args ...string) {
+func (tm *TaskManager) ExecuteTask(task *task.Task, args ...string) error {
+	return manager.CommandExecute(tm.TaskCommand(task), nil, args...)
+}
+
+func (tm *TaskManager) TaskCommand(task *task.Task) *exec.Cmd {
 	cmd := exec.Command("task", task.Name)
-	manager.CommandExecute(cmd, args...)
+	cmd.Dir = tm.dir
+	return cmd
 }
 
 func (tm *TaskManager) GetTitle() manager.Title {
@@ -121,3 +353,10 @@ func (tm *TaskManager) GetTitle() manager.Title {
 		Description: "parsed from " + strings.Join(tm.filenames, ", "),
 	}
 }
+
+func (tm *TaskManager) GetSource() manager.Source {
+	return manager.Source{
+		Filename: strings.Join(tm.filenames, ", "),
+		Dir:      tm.dir,
+	}
+}
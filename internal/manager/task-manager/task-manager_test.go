@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	manager "github.com/dmitriy-rs/rollercoaster/internal/manager/task-manager"
+	"github.com/dmitriy-rs/rollercoaster/internal/task"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -499,3 +500,93 @@ func TestParseTaskManager_AllFilenameVariations(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTaskManager_Includes(t *testing.T) {
+	testDir := filepath.Join("testdata", "includes")
+
+	tm, err := manager.ParseTaskManager(&testDir)
+	require.NoError(t, err, "Should parse Taskfile with includes without error")
+	require.NotNil(t, tm, "Should return TaskManager")
+
+	tasks, err := tm.ListTasks()
+	require.NoError(t, err, "Should list tasks without error")
+
+	expected := []task.Task{
+		{Name: "build", Description: "Build the application"},
+		{Name: "docs:serve", Description: "Serve documentation"},
+		{Name: "setup", Description: "Prepare environment"},
+		{Name: "tools:fmt", Description: "Format code", Aliases: []string{"t:fmt"}},
+		{Name: "tools:nested:deep", Description: "Nested task", Aliases: []string{"t:nested:deep"}},
+		{Name: "tools:vet", Description: "Vet code", Aliases: []string{"tools:v", "t:vet", "t:v"}},
+	}
+	assert.Equal(t, expected, tasks, "Should list included tasks under their namespaces")
+}
+
+func TestParseTaskManager_UnresolvableIncludes(t *testing.T) {
+	testDir := filepath.Join("testdata", "missing-include")
+
+	tm, err := manager.ParseTaskManager(&testDir)
+	require.NoError(t, err, "Should skip includes which can't be resolved")
+	require.NotNil(t, tm, "Should return TaskManager")
+
+	tasks, err := tm.ListTasks()
+	require.NoError(t, err, "Should list tasks without error")
+
+	expected := []task.Task{
+		{Name: "build"},
+		{Name: "docs:serve", Description: "Serve documentation"},
+	}
+	assert.Equal(t, expected, tasks, "Should list the tasks of the Taskfile and of resolved includes")
+}
+
+func TestParseTaskManager_IncludeErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		testdataDir string
+	}{
+		{
+			name:        "include_cycle",
+			testdataDir: "testdata/cycle",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm, err := manager.ParseTaskManager(&tt.testdataDir)
+			assert.Error(t, err, "Should return error for %s", tt.testdataDir)
+			assert.Nil(t, tm, "Should return nil TaskManager for error case")
+		})
+	}
+}
+
+func TestParseTaskManager_TaskAttributes(t *testing.T) {
+	testDir := filepath.Join("testdata", "task-attributes")
+
+	tm, err := manager.ParseTaskManager(&testDir)
+	require.NoError(t, err, "Should parse Taskfile without error")
+	require.NotNil(t, tm, "Should return TaskManager")
+
+	tasks, err := tm.ListTasks()
+	require.NoError(t, err, "Should list tasks without error")
+
+	expected := []task.Task{
+		{
+			Name:        "build",
+			Description: "Build the application",
+			Summary:     "Build the application\n\nCompiles every package into ./bin",
+			Aliases:     []string{"b", "compile"},
+		},
+		{
+			Name:        "deploy",
+			Description: "Deploy to production",
+			Prompts:     []string{"This will deploy to production. Continue?"},
+		},
+		{Name: "everywhere", Description: "Runs on common platforms"},
+		{
+			Name:        "release",
+			Description: "Publish a release",
+			Prompts:     []string{"Did you update the changelog?", "Are you sure?"},
+		},
+	}
+	assert.Equal(t, expected, tasks, "Should hide internal and foreign platform tasks")
+}
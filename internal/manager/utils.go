@@ -1,10 +1,15 @@
 package manager
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"slices"
 	"strings"
+	"syscall"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/dmitriy-rs/rollercoaster/internal/logger"
@@ -14,17 +19,110 @@ var commandTextStyle = lipgloss.NewStyle().
 	Foreground(lipgloss.Color("#6b9bd1")).
 	Bold(true)
 
-func CommandExecute(cmd *exec.Cmd, args ...string) {
+// ExitError is returned when an executed task exits with a non-zero status
+type ExitError struct {
+	Command string
+	Code    int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("%s exited with code %d", e.Command, e.Code)
+}
+
+func CommandExecute(cmd *exec.Cmd, options *ExecuteOptions, args ...string) error {
 	if len(args) > 0 {
 		cmd.Args = append(cmd.Args, args...)
 	}
 
-	logger.Info(fmt.Sprintf("Executing: %s", commandTextStyle.Render(strings.Join(cmd.Args, " "))))
+	if options.runInCurrentDir() {
+		cmd.Dir = ""
+	}
+
+	if options.IsDryRun() {
+		return PrintCommand(os.Stdout, cmd)
+	}
+
+	command := logExecution(cmd)
 
+	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
-	if err := cmd.Run(); err != nil {
-		// logger.Fatal(err)
-		return
+	return commandError(command, cmd.Run())
+}
+
+// logExecution logs the command about to be executed and returns its command line
+func logExecution(cmd *exec.Cmd) string {
+	command := strings.Join(cmd.Args, " ")
+	if cwd, err := os.Getwd(); err == nil && cmd.Dir != "" && filepath.Clean(cmd.Dir) != cwd {
+		logger.Info(fmt.Sprintf("Executing: %s in %s", commandTextStyle.Render(command), cmd.Dir))
+	} else {
+		logger.Info(fmt.Sprintf("Executing: %s", commandTextStyle.Render(command)))
+	}
+	return command
+}
+
+// commandError converts a non-zero exit status of the command into ExitError
+func commandError(command string, err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		code := exitErr.ExitCode()
+		// Killed by a signal, report it like shells do
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			code = 128 + int(status.Signal())
+		} else if code < 0 {
+			code = 1
+		}
+		return &ExitError{Command: command, Code: code}
+	}
+	return err
+}
+
+// PrintCommand prints the argv, working directory and environment overrides of cmd
+func PrintCommand(w io.Writer, cmd *exec.Cmd) error {
+	argv := make([]string, len(cmd.Args))
+	for i, arg := range cmd.Args {
+		argv[i] = quoteArg(arg)
+	}
+
+	dir := cmd.Dir
+	if dir == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		dir = cwd
+	}
+
+	_, err := fmt.Fprintf(w, "command: %s\ndir: %s\n", strings.Join(argv, " "), dir)
+	if err != nil {
+		return err
+	}
+
+	for _, env := range envOverrides(cmd.Env, os.Environ()) {
+		if _, err := fmt.Fprintf(w, "env: %s\n", quoteArg(env)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// envOverrides returns the entries of env which are not inherited as is from the current environment
+func envOverrides(env []string, environ []string) []string {
+	if env == nil {
+		return nil
+	}
+	overrides := []string{}
+	for _, entry := range env {
+		if !slices.Contains(environ, entry) {
+			overrides = append(overrides, entry)
+		}
+	}
+	return overrides
+}
+
+func quoteArg(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n\"'\\$`|&;<>()*?[]{}~#!") {
+		return arg
 	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
 }
@@ -1,13 +1,17 @@
 package manager_test
 
 import (
+	"bytes"
+	"errors"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 	"testing"
 
 	"github.com/dmitriy-rs/rollercoaster/internal/manager"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTaskExecute_SuccessfulCommand(t *testing.T) {
@@ -21,7 +25,7 @@ func TestTaskExecute_SuccessfulCommand(t *testing.T) {
 
 	// Execute the task - should complete without error
 	assert.NotPanics(t, func() {
-		manager.CommandExecute(cmd)
+		assert.NoError(t, manager.CommandExecute(cmd, nil), "CommandExecute should not return error on successful command")
 	}, "CommandExecute should not panic on successful command")
 
 	// The output will go to os.Stdout as intended by the function
@@ -37,9 +41,38 @@ func TestTaskExecute_CommandWithError(t *testing.T) {
 	}
 
 	// Execute the task - should not panic or exit
+	var err error
 	assert.NotPanics(t, func() {
-		manager.CommandExecute(cmd)
+		err = manager.CommandExecute(cmd, nil)
 	}, "CommandExecute should handle errors gracefully without panicking")
+
+	var exitErr *manager.ExitError
+	require.ErrorAs(t, err, &exitErr, "CommandExecute should return ExitError for failed command")
+	assert.Equal(t, 1, exitErr.Code, "ExitError should carry the child exit code")
+}
+
+func TestTaskExecute_PropagatesExitCode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sh is not available on windows")
+	}
+
+	err := manager.CommandExecute(exec.Command("sh", "-c", "exit 42"), nil)
+
+	var exitErr *manager.ExitError
+	require.ErrorAs(t, err, &exitErr, "CommandExecute should return ExitError for failed command")
+	assert.Equal(t, 42, exitErr.Code, "ExitError should carry the child exit code")
+}
+
+func TestTaskExecute_KilledBySignal(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("signals are not available on windows")
+	}
+
+	err := manager.CommandExecute(exec.Command("sh", "-c", "kill -TERM $$"), nil)
+
+	var exitErr *manager.ExitError
+	require.ErrorAs(t, err, &exitErr, "CommandExecute should return ExitError for killed command")
+	assert.Equal(t, 128+15, exitErr.Code, "ExitError should carry 128 plus the signal number")
 }
 
 func TestTaskExecute_WithAdditionalArgs(t *testing.T) {
@@ -55,7 +88,7 @@ func TestTaskExecute_WithAdditionalArgs(t *testing.T) {
 	originalArgsLen := len(cmd.Args)
 
 	// Execute with additional arguments
-	manager.CommandExecute(cmd, "test", "argument")
+	manager.CommandExecute(cmd, nil, "test", "argument")
 
 	// Verify the arguments were added (they should be added before execution)
 	assert.Greater(t, len(cmd.Args), originalArgsLen, "Additional arguments should be added to command")
@@ -85,7 +118,7 @@ func TestTaskExecute_NoAdditionalArgs(t *testing.T) {
 	originalArgsLen := len(cmd.Args)
 
 	// Execute without additional arguments
-	manager.CommandExecute(cmd)
+	manager.CommandExecute(cmd, nil)
 
 	// Verify no additional args were added
 	assert.Equal(t, originalArgsLen, len(cmd.Args), "No additional arguments should be added when none provided")
@@ -105,7 +138,7 @@ func TestTaskExecute_StdoutStderrAssignment(t *testing.T) {
 	assert.Nil(t, cmd.Stderr, "cmd.Stderr should be nil initially")
 
 	// Execute the task
-	manager.CommandExecute(cmd)
+	manager.CommandExecute(cmd, nil)
 
 	// Verify that stdout and stderr are set to os.Stdout and os.Stderr
 	assert.Equal(t, os.Stdout, cmd.Stdout, "cmd.Stdout should be set to os.Stdout")
@@ -117,9 +150,14 @@ func TestTaskExecute_CommandNotFound(t *testing.T) {
 	cmd := exec.Command("nonexistentcommand12345")
 
 	// Execute the task - should handle the error gracefully
+	var err error
 	assert.NotPanics(t, func() {
-		manager.CommandExecute(cmd)
+		err = manager.CommandExecute(cmd, nil)
 	}, "CommandExecute should handle non-existent commands gracefully without panicking")
+
+	var exitErr *manager.ExitError
+	assert.Error(t, err, "CommandExecute should return error for missing command")
+	assert.False(t, errors.As(err, &exitErr), "Missing command should not be reported as ExitError")
 }
 
 func TestTaskExecute_EmptyCommand(t *testing.T) {
@@ -128,6 +166,87 @@ func TestTaskExecute_EmptyCommand(t *testing.T) {
 
 	// Execute the task - should handle the error gracefully
 	assert.NotPanics(t, func() {
-		manager.CommandExecute(cmd)
+		manager.CommandExecute(cmd, nil)
 	}, "CommandExecute should handle empty commands gracefully without panicking")
 }
+
+func TestTaskExecute_RunsInCommandDir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sh is not available on windows")
+	}
+	dir := t.TempDir()
+
+	cmd := exec.Command("sh", "-c", `test -f manifest`)
+	cmd.Dir = dir
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "manifest"), []byte{}, 0644))
+
+	assert.NoError(t, manager.CommandExecute(cmd, nil), "Command should run in the manifest directory")
+}
+
+func TestTaskExecute_RunInCurrentDir(t *testing.T) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/c", "echo", "test")
+	} else {
+		cmd = exec.Command("echo", "test")
+	}
+	cmd.Dir = t.TempDir()
+
+	assert.NoError(t, manager.CommandExecute(cmd, &manager.ExecuteOptions{RunInCurrentDir: true}), "CommandExecute should not return error")
+	assert.Empty(t, cmd.Dir, "Command directory should be reset to run in the invoking directory")
+}
+
+func TestPrintCommand(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name     string
+		cmd      func() *exec.Cmd
+		expected string
+	}{
+		{
+			name: "command with directory",
+			cmd: func() *exec.Cmd {
+				cmd := exec.Command("npm", "run", "build")
+				cmd.Dir = dir
+				return cmd
+			},
+			expected: "command: npm run build\ndir: " + dir + "\n",
+		},
+		{
+			name: "arguments with spaces are quoted",
+			cmd: func() *exec.Cmd {
+				cmd := exec.Command("task", "deploy", "--", "it's prod")
+				cmd.Dir = dir
+				return cmd
+			},
+			expected: "command: task deploy -- 'it'\\''s prod'\ndir: " + dir + "\n",
+		},
+		{
+			name: "environment overrides",
+			cmd: func() *exec.Cmd {
+				cmd := exec.Command("make", "build")
+				cmd.Dir = dir
+				cmd.Env = append(os.Environ(), "NODE_ENV=production")
+				return cmd
+			},
+			expected: "command: make build\ndir: " + dir + "\nenv: NODE_ENV=production\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, manager.PrintCommand(&buf, tt.cmd()))
+			assert.Equal(t, tt.expected, buf.String(), "PrintCommand() should print argv, directory and environment overrides")
+		})
+	}
+}
+
+func TestTaskExecute_DryRun(t *testing.T) {
+	cmd := exec.Command("sh", "-c", "exit 1")
+
+	assert.NoError(t, manager.CommandExecute(cmd, &manager.ExecuteOptions{DryRun: true}, "extra"), "Dry run should not execute the command")
+	assert.Nil(t, cmd.ProcessState, "Dry run should not start the process")
+	assert.Equal(t, []string{"sh", "-c", "exit 1", "extra"}, cmd.Args, "Dry run should still resolve arguments")
+}
@@ -0,0 +1,71 @@
+package sequence
+
+import "slices"
+
+// Separator separates the tasks of a sequence, every task can have its own arguments
+const Separator = "+"
+
+// Step is a task query with the arguments passed to the task
+type Step struct {
+	Query string
+	Args  []string
+}
+
+// Parse splits the command line arguments into steps, argsLenAtDash is the number of arguments before "--" or -1.
+//
+//	lint + test --watch      lint, then test with --watch
+//	lint test build --       lint, test and build without arguments
+//	lint test -- --fix       lint, then test with --fix
+//	add lodash               a single add task with lodash
+//	add lodash -- -D         add, then lodash with -D
+//	add -- lodash -D         a single add task with lodash and -D
+//
+// Arguments after "--" are passed to the last step and never split.
+func Parse(args []string, argsLenAtDash int) []Step {
+	if len(args) == 0 {
+		return nil
+	}
+
+	queries, passThrough := args, []string{}
+	if argsLenAtDash >= 0 {
+		queries, passThrough = args[:argsLenAtDash], args[argsLenAtDash:]
+	}
+
+	var steps []Step
+	switch {
+	case slices.Contains(queries, Separator):
+		for _, segment := range splitSegments(queries) {
+			steps = append(steps, Step{Query: segment[0], Args: slices.Clone(segment[1:])})
+		}
+	case len(queries) > 1 && argsLenAtDash >= 0:
+		for _, query := range queries {
+			steps = append(steps, Step{Query: query})
+		}
+	default:
+		// A single task with all arguments as before sequences were introduced
+		return []Step{{Query: args[0], Args: slices.Clone(args[1:])}}
+	}
+
+	if len(steps) == 0 {
+		return nil
+	}
+	last := &steps[len(steps)-1]
+	last.Args = append(last.Args, passThrough...)
+	return steps
+}
+
+// splitSegments returns the non-empty parts of args between separators
+func splitSegments(args []string) [][]string {
+	segments := [][]string{}
+	start := 0
+	for i := 0; i <= len(args); i++ {
+		if i < len(args) && args[i] != Separator {
+			continue
+		}
+		if i > start {
+			segments = append(segments, args[start:i])
+		}
+		start = i + 1
+	}
+	return segments
+}
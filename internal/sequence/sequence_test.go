@@ -0,0 +1,111 @@
+package sequence_test
+
+import (
+	"testing"
+
+	"github.com/dmitriy-rs/rollercoaster/internal/sequence"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		argsLenAtDash int
+		expected      []sequence.Step
+	}{
+		{
+			name:          "no arguments",
+			args:          []string{},
+			argsLenAtDash: -1,
+			expected:      nil,
+		},
+		{
+			name:          "single task with arguments",
+			args:          []string{"add", "lodash"},
+			argsLenAtDash: -1,
+			expected:      []sequence.Step{{Query: "add", Args: []string{"lodash"}}},
+		},
+		{
+			name:          "single task with arguments after dash",
+			args:          []string{"test", "--watch"},
+			argsLenAtDash: 1,
+			expected:      []sequence.Step{{Query: "test", Args: []string{"--watch"}}},
+		},
+		{
+			name:          "tasks separated by plus",
+			args:          []string{"lint", "+", "test", "unit", "+", "build"},
+			argsLenAtDash: -1,
+			expected: []sequence.Step{
+				{Query: "lint", Args: []string{}},
+				{Query: "test", Args: []string{"unit"}},
+				{Query: "build", Args: []string{}},
+			},
+		},
+		{
+			name:          "empty segments are ignored",
+			args:          []string{"+", "lint", "+", "+", "test", "+"},
+			argsLenAtDash: -1,
+			expected: []sequence.Step{
+				{Query: "lint", Args: []string{}},
+				{Query: "test", Args: []string{}},
+			},
+		},
+		{
+			name:          "tasks before dash",
+			args:          []string{"lint", "test", "build"},
+			argsLenAtDash: 3,
+			expected: []sequence.Step{
+				{Query: "lint"},
+				{Query: "test"},
+				{Query: "build"},
+			},
+		},
+		{
+			// Before sequences "add lodash -- -D" ran add with lodash and -D
+			name:          "arguments before dash are tasks",
+			args:          []string{"add", "lodash", "-D"},
+			argsLenAtDash: 2,
+			expected: []sequence.Step{
+				{Query: "add"},
+				{Query: "lodash", Args: []string{"-D"}},
+			},
+		},
+		{
+			name:          "all arguments after dash",
+			args:          []string{"add", "lodash", "-D"},
+			argsLenAtDash: 1,
+			expected:      []sequence.Step{{Query: "add", Args: []string{"lodash", "-D"}}},
+		},
+		{
+			name:          "arguments after dash are passed to the last task",
+			args:          []string{"lint", "test", "--coverage", "+", "x"},
+			argsLenAtDash: 2,
+			expected: []sequence.Step{
+				{Query: "lint"},
+				{Query: "test", Args: []string{"--coverage", "+", "x"}},
+			},
+		},
+		{
+			name:          "plus and dash",
+			args:          []string{"lint", "+", "test", "--fix"},
+			argsLenAtDash: 3,
+			expected: []sequence.Step{
+				{Query: "lint", Args: []string{}},
+				{Query: "test", Args: []string{"--fix"}},
+			},
+		},
+		{
+			name:          "only separators",
+			args:          []string{"+"},
+			argsLenAtDash: -1,
+			expected:      nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, sequence.Parse(tt.args, tt.argsLenAtDash))
+		})
+	}
+}
@@ -8,7 +8,9 @@ import (
 type Task struct {
 	Name        string
 	Description string
+	Summary     string // optional extended description
 	Aliases     []string
+	Prompts     []string // confirmation questions defined by the task itself
 }
 
 type TaskSource []Task
@@ -0,0 +1,32 @@
+package ui
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var promptStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("#ffef50")).
+	Bold(true)
+
+// Confirm asks a yes/no question, anything except "y" or "yes" is treated as no
+func Confirm(in io.Reader, out io.Writer, message string) (bool, error) {
+	if _, err := fmt.Fprintf(out, "%s [y/N] ", promptStyle.Render(message)); err != nil {
+		return false, err
+	}
+
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
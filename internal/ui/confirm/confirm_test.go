@@ -0,0 +1,38 @@
+package ui_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	ui "github.com/dmitriy-rs/rollercoaster/internal/ui/confirm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirm(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{name: "yes", input: "y\n", expected: true},
+		{name: "full yes with spaces", input: "  Yes \n", expected: true},
+		{name: "no", input: "n\n", expected: false},
+		{name: "empty answer defaults to no", input: "\n", expected: false},
+		{name: "closed input defaults to no", input: "", expected: false},
+		{name: "answer without newline", input: "y", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+
+			confirmed, err := ui.Confirm(strings.NewReader(tt.input), &out, "Run deploy?")
+			require.NoError(t, err, "Confirm() should not return error")
+			assert.Equal(t, tt.expected, confirmed, "Confirm() result should match for input %q", tt.input)
+			assert.Contains(t, out.String(), "Run deploy?", "Confirm() should print the question")
+			assert.Contains(t, out.String(), "[y/N]", "Confirm() should show the default answer")
+		})
+	}
+}
@@ -57,11 +57,12 @@ func (m *MockManager) ListTasks() ([]task.Task, error) {
 	return m.tasks, nil
 }
 
-func (m *MockManager) ExecuteTask(task *task.Task, args ...string) {
+func (m *MockManager) ExecuteTask(task *task.Task, args ...string) error {
 	m.executed = append(m.executed, ExecutedTask{
 		Task: task,
 		Args: args,
 	})
+	return m.executeError
 }
 
 func (m *MockManager) SetListError(err error) {
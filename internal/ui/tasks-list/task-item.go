@@ -3,6 +3,9 @@ package ui
 import (
 	"fmt"
 	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
@@ -13,21 +16,124 @@ import (
 var (
 	itemStyle         = lipgloss.NewStyle().PaddingLeft(4).Foreground(lipgloss.Color("#CCCCCC"))
 	selectedItemStyle = lipgloss.NewStyle().PaddingLeft(2).Foreground(lipgloss.Color("39"))
+	itemTitleStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#CCCCCC"))
+	matchStyle        = lipgloss.NewStyle().Underline(true).Foreground(lipgloss.Color("212"))
+	selectedMarkStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	aliasStyle        = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
 )
 
 // managerTaskItem wraps manager.ManagerTask to implement list.Item interface
 type managerTaskItem struct {
 	ManagerTask manager.ManagerTask
+	// selected is toggled with space to run several tasks at once
+	selected bool
+	// filterDescription adds the description to the filter value, as descriptions are matched with a weight
+	filterDescription bool
 }
 
 func (t managerTaskItem) Title() string {
-	if len(t.ManagerTask.Aliases) > 0 {
-		return t.ManagerTask.Aliases[0]
-	}
 	return t.ManagerTask.Name
 }
 
-func (t managerTaskItem) FilterValue() string { return t.ManagerTask.Name }
+// FilterValue lets the list filter by name and aliases, and by description when it is matched as well
+func (t managerTaskItem) FilterValue() string {
+	fields := append([]string{t.ManagerTask.Name}, t.ManagerTask.Aliases...)
+	if t.filterDescription {
+		fields = append(fields, t.ManagerTask.Description)
+	}
+	return strings.Join(fields, " ")
+}
+
+// matchedField returns the name or alias matching the query and the byte indexes of its matched runes.
+// While the user filters, the name or alias with the most filter matches is returned.
+func (t managerTaskItem) matchedField(filter string, filterMatches []int) (string, []int) {
+	match := t.ManagerTask.Match
+	switch {
+	case match.Alias != "" && (filter == "" || filter == match.Alias):
+		return match.Alias, match.Indexes
+	case filter != "":
+		return t.filterMatchedField(filterMatches)
+	default:
+		return t.ManagerTask.Name, match.Indexes
+	}
+}
+
+// filterMatchedField maps the list filter matches on the filter value to the name or alias having most of them
+func (t managerTaskItem) filterMatchedField(filterMatches []int) (string, []int) {
+	title, indexes := t.ManagerTask.Name, []int(nil)
+	start := 0
+	for _, field := range append([]string{t.ManagerTask.Name}, t.ManagerTask.Aliases...) {
+		var fieldIndexes []int
+		for _, index := range filterMatches {
+			if index >= start && index < start+len(field) {
+				fieldIndexes = append(fieldIndexes, index-start)
+			}
+		}
+		if len(fieldIndexes) > len(indexes) {
+			title, indexes = field, fieldIndexes
+		}
+		// Fields are separated by a space
+		start += len(field) + 1
+	}
+	return title, indexes
+}
+
+// highlightTitle truncates the title to width and pads it, runes at the matched byte indexes are rendered with matched style
+func highlightTitle(title string, indexes []int, width int, style lipgloss.Style, matched lipgloss.Style) string {
+	ellipsis := ""
+	if utf8.RuneCountInString(title) > width {
+		title = string([]rune(title)[:width-3])
+		ellipsis = "..."
+	}
+
+	padding := width - utf8.RuneCountInString(title) - len(ellipsis)
+	return highlight(title, indexes, style, matched) + style.Render(ellipsis+strings.Repeat(" ", padding))
+}
+
+// highlightAliases renders the aliases in parentheses, the matched alias is highlighted
+func highlightAliases(aliases []string, field string, indexes []int, style lipgloss.Style, matched lipgloss.Style) string {
+	if len(aliases) == 0 {
+		return ""
+	}
+
+	rendered := make([]string, len(aliases))
+	for i, alias := range aliases {
+		var aliasIndexes []int
+		if alias == field {
+			aliasIndexes = indexes
+		}
+		rendered[i] = highlight(alias, aliasIndexes, style, matched)
+	}
+	return style.Render("(") + strings.Join(rendered, style.Render(", ")) + style.Render(") ")
+}
+
+// highlight renders runes at the matched byte indexes with matched style and the rest with style
+func highlight(text string, indexes []int, style lipgloss.Style, matched lipgloss.Style) string {
+	var b strings.Builder
+	var segment strings.Builder
+	segmentMatched := false
+	flush := func() {
+		if segment.Len() == 0 {
+			return
+		}
+		if segmentMatched {
+			b.WriteString(matched.Render(segment.String()))
+		} else {
+			b.WriteString(style.Render(segment.String()))
+		}
+		segment.Reset()
+	}
+	for i, r := range text {
+		isMatched := slices.Contains(indexes, i)
+		if isMatched != segmentMatched {
+			flush()
+			segmentMatched = isMatched
+		}
+		segment.WriteRune(r)
+	}
+	flush()
+	return b.String()
+}
 
 type itemDelegate struct {
 	showManagerIndicator bool
@@ -37,14 +143,22 @@ func (d itemDelegate) Height() int                             { return 1 }
 func (d itemDelegate) Spacing() int                            { return 0 }
 func (d itemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
 func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
-	var taskTitle, taskDescription string
+	var taskTitle, taskDescription, matchedField string
+	var taskAliases []string
+	var matchedIndexes []int
 	var managerTitle manager.Title
+	selectionMark := "  "
 
 	// Handle the new managerTaskItem type
 	if item, ok := listItem.(managerTaskItem); ok {
 		taskTitle = item.Title()
+		taskAliases = item.ManagerTask.Aliases
+		matchedField, matchedIndexes = item.matchedField(m.FilterValue(), m.MatchesForItem(index))
 		taskDescription = item.ManagerTask.Description
 		managerTitle = (*item.ManagerTask.Manager).GetTitle()
+		if item.selected {
+			selectionMark = selectedMarkStyle.Render("✓ ")
+		}
 	} else {
 		// Fallback for other item types (not used in new implementation)
 		return
@@ -55,13 +169,15 @@ func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list
 		description = description[:47] + "..."
 	}
 
-	titleWidth := 18
-	title := taskTitle
-	if len(title) > titleWidth {
-		title = title[:titleWidth-3] + "..."
+	// Only the matched field is highlighted, either the name or one of the aliases
+	titleIndexes := matchedIndexes
+	if matchedField != taskTitle {
+		titleIndexes = nil
 	}
 
-	paddedTitle := fmt.Sprintf("%-*s", titleWidth, title)
+	titleWidth := 18
+	paddedTitle := highlightTitle(taskTitle, titleIndexes, titleWidth, itemTitleStyle, matchStyle)
+	aliases := highlightAliases(taskAliases, matchedField, matchedIndexes, aliasStyle, matchStyle)
 
 	// Add manager indicator with fixed width for alignment - only if needed
 	managerIndicator := ""
@@ -75,13 +191,13 @@ func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list
 		managerIndicator = lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(fmt.Sprintf("%-8s", indicator))
 	}
 
-	str := fmt.Sprintf("%2d. %s%s %s", index+1, managerIndicator, paddedTitle, description)
+	str := fmt.Sprintf("%2d. %s%s%s %s%s", index+1, selectionMark, managerIndicator, paddedTitle, aliases, description)
 
 	fn := itemStyle.Render
 	if index == m.Index() {
-		boldTitle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39")).Render(paddedTitle)
+		boldTitle := highlightTitle(taskTitle, titleIndexes, titleWidth, lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39")), matchStyle.Bold(true))
 		highlightedDescription := lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Render(description)
-		boldStr := fmt.Sprintf("%2d. %s%s %s", index+1, managerIndicator, boldTitle, highlightedDescription)
+		boldStr := fmt.Sprintf("%2d. %s%s%s %s%s", index+1, selectionMark, managerIndicator, boldTitle, aliases, highlightedDescription)
 		fn = func(s ...string) string {
 			return selectedItemStyle.Render("> " + boldStr)
 		}
@@ -2,9 +2,11 @@ package ui
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/dmitriy-rs/rollercoaster/internal/manager"
 	"github.com/dmitriy-rs/rollercoaster/internal/task"
 	"github.com/stretchr/testify/assert"
@@ -21,16 +23,17 @@ type mockManager struct {
 	title manager.Title
 }
 
-func (m *mockManager) GetTitle() manager.Title                     { return m.title }
-func (m *mockManager) ListTasks() ([]task.Task, error)             { return nil, nil }
-func (m *mockManager) ExecuteTask(task *task.Task, args ...string) {}
+func (m *mockManager) GetTitle() manager.Title                           { return m.title }
+func (m *mockManager) ListTasks() ([]task.Task, error)                   { return nil, nil }
+func (m *mockManager) ExecuteTask(task *task.Task, args ...string) error { return nil }
 
 func TestManagerTaskItem(t *testing.T) {
 	tests := []struct {
-		name         string
-		task         task.Task
-		expectTitle  string
-		expectFilter string
+		name              string
+		task              task.Task
+		filterDescription bool
+		expectTitle       string
+		expectFilter      string
 	}{
 		{
 			name: "task with aliases",
@@ -38,8 +41,8 @@ func TestManagerTaskItem(t *testing.T) {
 				Name:    "build",
 				Aliases: []string{"b", "compile"},
 			},
-			expectTitle:  "b",
-			expectFilter: "build",
+			expectTitle:  "build",
+			expectFilter: "build b compile",
 		},
 		{
 			name: "task without aliases",
@@ -59,6 +62,25 @@ func TestManagerTaskItem(t *testing.T) {
 			expectTitle:  "deploy",
 			expectFilter: "deploy",
 		},
+		{
+			name: "task with description",
+			task: task.Task{
+				Name:        "lint",
+				Description: "Check the code",
+			},
+			expectTitle:  "lint",
+			expectFilter: "lint",
+		},
+		{
+			name: "task with filtered description",
+			task: task.Task{
+				Name:        "lint",
+				Description: "Check the code",
+			},
+			filterDescription: true,
+			expectTitle:       "lint",
+			expectFilter:      "lint Check the code",
+		},
 	}
 
 	var mgr manager.Manager = &mockManager{title: manager.Title{Name: "test", Description: "Test manager"}}
@@ -69,7 +91,7 @@ func TestManagerTaskItem(t *testing.T) {
 				Task:    tt.task,
 				Manager: &mgr,
 			}
-			item := managerTaskItem{ManagerTask: managerTask}
+			item := managerTaskItem{ManagerTask: managerTask, filterDescription: tt.filterDescription}
 
 			assert.Equal(t, tt.expectTitle, item.Title())
 			assert.Equal(t, tt.expectFilter, item.FilterValue())
@@ -77,6 +99,121 @@ func TestManagerTaskItem(t *testing.T) {
 	}
 }
 
+func TestManagerTaskItem_MatchedField(t *testing.T) {
+	buildTask := task.Task{Name: "build", Aliases: []string{"b", "compile"}}
+
+	tests := []struct {
+		name          string
+		match         manager.TaskMatch
+		filter        string
+		filterMatches []int
+		expectTitle   string
+		expectIndexes []int
+	}{
+		{
+			name:        "no match",
+			expectTitle: "build",
+		},
+		{
+			name:          "name matched",
+			match:         manager.TaskMatch{Indexes: []int{0, 4}},
+			expectTitle:   "build",
+			expectIndexes: []int{0, 4},
+		},
+		{
+			name:          "alias matched",
+			match:         manager.TaskMatch{Alias: "compile", Indexes: []int{0, 1, 2, 3, 4, 5, 6}},
+			expectTitle:   "compile",
+			expectIndexes: []int{0, 1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:          "alias matched the filter",
+			match:         manager.TaskMatch{Alias: "compile", Indexes: []int{0, 1, 2, 3, 4, 5, 6}},
+			filter:        "compile",
+			expectTitle:   "compile",
+			expectIndexes: []int{0, 1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:          "filter changed",
+			match:         manager.TaskMatch{Alias: "compile", Indexes: []int{0, 1, 2, 3, 4, 5, 6}},
+			filter:        "bd",
+			filterMatches: []int{0, 4},
+			expectTitle:   "build",
+			expectIndexes: []int{0, 4},
+		},
+		{
+			name:          "filter matched an alias",
+			filter:        "cmp",
+			filterMatches: []int{8, 10, 11},
+			expectTitle:   "compile",
+			expectIndexes: []int{0, 2, 3},
+		},
+	}
+
+	var mgr manager.Manager = &mockManager{title: manager.Title{Name: "test"}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := managerTaskItem{ManagerTask: manager.ManagerTask{Task: buildTask, Manager: &mgr, Match: tt.match}}
+
+			title, indexes := item.matchedField(tt.filter, tt.filterMatches)
+			assert.Equal(t, tt.expectTitle, title)
+			assert.Equal(t, tt.expectIndexes, indexes)
+		})
+	}
+}
+
+func TestHighlightTitle(t *testing.T) {
+	// Color output is disabled in tests, so matches are made visible by upper casing them
+	upper := lipgloss.NewStyle().Transform(strings.ToUpper)
+
+	tests := []struct {
+		name    string
+		title   string
+		indexes []int
+		expect  string
+	}{
+		{
+			name:   "no matches",
+			title:  "build",
+			expect: "build     ",
+		},
+		{
+			name:    "matched letters",
+			title:   "build",
+			indexes: []int{0, 2, 3},
+			expect:  "BuILd     ",
+		},
+		{
+			name:    "matches after truncation are dropped",
+			title:   "generate-all",
+			indexes: []int{0, 11},
+			expect:  "Generat...",
+		},
+		{
+			name:    "multibyte runes",
+			title:   "über",
+			indexes: []int{0, 2},
+			expect:  "ÜBer      ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, highlightTitle(tt.title, tt.indexes, 10, lipgloss.NewStyle(), upper))
+		})
+	}
+}
+
+func TestHighlightAliases(t *testing.T) {
+	upper := lipgloss.NewStyle().Transform(strings.ToUpper)
+	aliases := []string{"b", "compile"}
+
+	assert.Equal(t, "", highlightAliases(nil, "build", nil, lipgloss.NewStyle(), upper))
+	assert.Equal(t, "(b, compile) ", highlightAliases(aliases, "build", []int{0}, lipgloss.NewStyle(), upper))
+	assert.Equal(t, "(b, CoMPile) ", highlightAliases(aliases, "compile", []int{0, 2, 3}, lipgloss.NewStyle(), upper))
+}
+
 func TestItemDelegate(t *testing.T) {
 	var mgr manager.Manager = &mockManager{title: manager.Title{Name: "task", Description: "Taskfile runner"}}
 
@@ -140,6 +277,30 @@ func TestItemDelegate(t *testing.T) {
 		assert.Contains(t, output, "[task]") // Manager indicator should be present
 	})
 
+	t.Run("render name with aliases", func(t *testing.T) {
+		delegate := itemDelegate{
+			showManagerIndicator: false,
+		}
+
+		managerTask := manager.ManagerTask{
+			Task:    task.Task{Name: "tools:vet", Description: "Vet code", Aliases: []string{"tools:v", "t:vet"}},
+			Manager: &mgr,
+		}
+
+		tasks := []list.Item{
+			managerTaskItem{ManagerTask: managerTask},
+		}
+
+		listModel := list.New(tasks, delegate, 80, 10)
+
+		var buf bytes.Buffer
+		delegate.Render(&buf, listModel, 0, tasks[0])
+
+		output := buf.String()
+		assert.Contains(t, output, "tools:vet ")
+		assert.Contains(t, output, "(tools:v, t:vet) Vet code")
+	})
+
 	t.Run("render with long descriptions", func(t *testing.T) {
 		delegate := itemDelegate{
 			showManagerIndicator: false,
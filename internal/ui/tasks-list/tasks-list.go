@@ -2,13 +2,14 @@ package ui
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/dmitriy-rs/rollercoaster/internal/manager"
-	"github.com/dmitriy-rs/rollercoaster/internal/task"
 )
 
 var (
@@ -16,12 +17,21 @@ var (
 	paginationStyle = list.DefaultStyles().PaginationStyle.PaddingLeft(4)
 	helpStyle       = list.DefaultStyles().HelpStyle.PaddingLeft(4).PaddingBottom(1)
 	quitTextStyle   = lipgloss.NewStyle().Margin(0, 0, 0, 0)
+	summaryStyle    = lipgloss.NewStyle().PaddingLeft(4).PaddingTop(1).Foreground(lipgloss.Color("#CCCCCC"))
+)
+
+var (
+	toggleKey   = key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "select"))
+	parallelKey = key.NewBinding(key.WithKeys("alt+enter", "ctrl+p"), key.WithHelp("alt+enter", "run in parallel"))
 )
 
 type managerModel struct {
-	list             list.Model
-	choice           task.Task
-	chosenManager    *manager.Manager
+	list   list.Model
+	chosen []manager.ManagerTask
+	// parallel is set when the chosen tasks should run concurrently
+	parallel bool
+	// selected holds the indexes of the toggled items in the order they were selected
+	selected         []int
 	quitting         bool
 	managerTasks     []manager.ManagerTask
 	hasInitialFilter bool // Track if initial filter was provided
@@ -44,14 +54,18 @@ func (m managerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.quitting = true
 			return m, tea.Quit
 
-		case "enter":
-			selectedItem := m.list.SelectedItem()
-			if item, ok := selectedItem.(managerTaskItem); ok {
-				m.choice = item.ManagerTask.Task
-				m.chosenManager = item.ManagerTask.Manager
-			}
+		case "enter", "alt+enter", "ctrl+p":
+			m.chosen = m.chosenTasks()
+			m.parallel = key.Matches(msg, parallelKey) && len(m.chosen) > 1
 			return m, tea.Quit
 
+		case " ":
+			// Space is a part of the query while typing the filter
+			if m.list.FilterState() == list.Filtering {
+				break
+			}
+			return m, m.toggleSelected()
+
 		case "left":
 			m.list.PrevPage()
 			// Adjust selection if current index is beyond available items on this page
@@ -105,9 +119,58 @@ func (m managerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// toggleSelected selects the current item or removes it from the selection
+func (m *managerModel) toggleSelected() tea.Cmd {
+	// GlobalIndex is 0 when the filter matches nothing, which is not the current item
+	if len(m.list.VisibleItems()) == 0 {
+		return nil
+	}
+
+	index := m.list.GlobalIndex()
+	item, ok := m.list.Items()[index].(managerTaskItem)
+	if !ok {
+		return nil
+	}
+
+	if position := slices.Index(m.selected, index); position != -1 {
+		m.selected = slices.Delete(slices.Clone(m.selected), position, position+1)
+		item.selected = false
+	} else {
+		m.selected = append(slices.Clone(m.selected), index)
+		item.selected = true
+	}
+	return m.list.SetItem(index, item)
+}
+
+// chosenTasks returns the selected tasks in the order they were selected, or the current task when nothing is selected
+func (m managerModel) chosenTasks() []manager.ManagerTask {
+	if len(m.selected) > 0 {
+		tasks := make([]manager.ManagerTask, 0, len(m.selected))
+		for _, index := range m.selected {
+			if item, ok := m.list.Items()[index].(managerTaskItem); ok {
+				tasks = append(tasks, item.ManagerTask)
+			}
+		}
+		return tasks
+	}
+
+	if item, ok := m.list.SelectedItem().(managerTaskItem); ok {
+		return []manager.ManagerTask{item.ManagerTask}
+	}
+	return nil
+}
+
+func taskNames(tasks []manager.ManagerTask) string {
+	names := make([]string, len(tasks))
+	for i, t := range tasks {
+		names[i] = t.Name
+	}
+	return strings.Join(names, ", ")
+}
+
 func (m managerModel) View() string {
-	if m.choice.Name != "" {
-		return quitTextStyle.Render(fmt.Sprintf("Selected: %s", m.choice.Name))
+	if len(m.chosen) > 0 {
+		return quitTextStyle.Render(fmt.Sprintf("Selected: %s", taskNames(m.chosen)))
 	}
 	if m.quitting {
 		return quitTextStyle.Render("")
@@ -132,24 +195,36 @@ func (m managerModel) View() string {
 
 	totalItems := len(m.list.Items())
 	statusInfo := fmt.Sprintf("tasks %d", totalItems)
+	if len(m.selected) > 0 {
+		statusInfo += fmt.Sprintf(" · selected %d: %s", len(m.selected), taskNames(m.chosenTasks()))
+	}
 
 	statusBar := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("240")).
 		PaddingLeft(4).
 		Render(statusInfo)
 
-	return header.String() + listView + "\n" + statusBar
+	// Show the extended description of the current task below the list
+	var summary string
+	if item, ok := m.list.SelectedItem().(managerTaskItem); ok && item.ManagerTask.Summary != "" {
+		summary = "\n" + summaryStyle.Render(item.ManagerTask.Summary)
+	}
+
+	return header.String() + listView + "\n" + statusBar + summary
 }
 
-func RenderTasksList(managerTasks []manager.ManagerTask, initialFilter string) (*manager.Manager, *task.Task, error) {
+// RenderTasksList lets the user pick tasks, they are returned in the order they were selected.
+// parallel is true when the user asked to run several tasks concurrently, options decide whether descriptions are filtered.
+func RenderTasksList(managerTasks []manager.ManagerTask, initialFilter string, options *manager.Options) (selected []manager.ManagerTask, parallel bool, err error) {
 	if len(managerTasks) == 0 {
-		return nil, nil, fmt.Errorf("no tasks provided")
+		return nil, false, fmt.Errorf("no tasks provided")
 	}
 
 	// Convert manager tasks to list items
+	filterDescription := options.Weights().Description > 0
 	var allItems []list.Item
 	for _, mgr := range managerTasks {
-		allItems = append(allItems, managerTaskItem{ManagerTask: mgr})
+		allItems = append(allItems, managerTaskItem{ManagerTask: mgr, filterDescription: filterDescription})
 	}
 
 	// Collect unique manager titles for determining if indicators should be shown
@@ -180,6 +255,12 @@ func RenderTasksList(managerTasks []manager.ManagerTask, initialFilter string) (
 	l.Styles.Title = titleStyle
 	l.Styles.PaginationStyle = paginationStyle
 	l.Styles.HelpStyle = helpStyle
+	l.AdditionalShortHelpKeys = func() []key.Binding {
+		return []key.Binding{toggleKey}
+	}
+	l.AdditionalFullHelpKeys = func() []key.Binding {
+		return []key.Binding{toggleKey, parallelKey}
+	}
 
 	hasInitialFilter := initialFilter != ""
 
@@ -197,16 +278,14 @@ func RenderTasksList(managerTasks []manager.ManagerTask, initialFilter string) (
 
 	finalModel, err := tea.NewProgram(m).Run()
 	if err != nil {
-		return nil, nil, err
+		return nil, false, err
 	}
 
-	// Extract the selected task from the final model
+	// Extract the selected tasks from the final model
 	if model, ok := finalModel.(managerModel); ok {
-		if model.choice.Name != "" && model.chosenManager != nil {
-			return model.chosenManager, &model.choice, nil
-		}
+		return model.chosen, model.parallel, nil
 	}
 
 	// User quit without selecting
-	return nil, nil, nil
+	return nil, false, nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/dmitriy-rs/rollercoaster/internal/task"
 	"github.com/dmitriy-rs/rollercoaster/internal/ui/tasks-list/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestShouldShowManagerIndicator(t *testing.T) {
@@ -105,8 +106,84 @@ func TestManagerModel_CoreFunctionality(t *testing.T) {
 
 		assert.NotNil(t, cmd)
 		modelTyped := updatedModel.(managerModel)
-		assert.NotEmpty(t, modelTyped.choice.Name)
-		assert.NotNil(t, modelTyped.chosenManager)
+		assert.Len(t, modelTyped.chosen, 1)
+		assert.Equal(t, tasks[0].Name, modelTyped.chosen[0].Name)
+		assert.NotNil(t, modelTyped.chosen[0].Manager)
+		assert.False(t, modelTyped.parallel)
+	})
+
+	t.Run("Update - space toggles selection", func(t *testing.T) {
+		space := tea.KeyMsg{Type: tea.KeySpace}
+		down := tea.KeyMsg{Type: tea.KeyDown}
+
+		updatedModel, _ := model.Update(space)
+		updatedModel, _ = updatedModel.Update(down)
+		updatedModel, _ = updatedModel.Update(space)
+		modelTyped := updatedModel.(managerModel)
+		assert.Equal(t, []int{0, 1}, modelTyped.selected)
+		assert.True(t, modelTyped.list.Items()[1].(managerTaskItem).selected)
+		assert.Contains(t, modelTyped.View(), "selected 2")
+
+		updatedModel, _ = updatedModel.Update(space)
+		modelTyped = updatedModel.(managerModel)
+		assert.Equal(t, []int{0}, modelTyped.selected)
+		assert.False(t, modelTyped.list.Items()[1].(managerTaskItem).selected)
+		// The original model is not modified
+		assert.Empty(t, model.selected)
+	})
+
+	t.Run("Update - space without filter matches selects nothing", func(t *testing.T) {
+		filtered := model
+		filtered.list = list.New(allItems, delegate, 80, 14)
+		filtered.list.SetFilterText("zzz")
+		require.Empty(t, filtered.list.VisibleItems())
+
+		updatedModel, _ := filtered.Update(tea.KeyMsg{Type: tea.KeySpace})
+		modelTyped := updatedModel.(managerModel)
+		assert.Empty(t, modelTyped.selected, "Hidden item should not be selected")
+	})
+
+	t.Run("Update - enter runs selected tasks in the order of selection", func(t *testing.T) {
+		space := tea.KeyMsg{Type: tea.KeySpace}
+		down := tea.KeyMsg{Type: tea.KeyDown}
+		up := tea.KeyMsg{Type: tea.KeyUp}
+
+		updatedModel, _ := model.Update(down)
+		updatedModel, _ = updatedModel.Update(down)
+		updatedModel, _ = updatedModel.Update(space)
+		updatedModel, _ = updatedModel.Update(up)
+		updatedModel, _ = updatedModel.Update(space)
+		updatedModel, cmd := updatedModel.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		assert.NotNil(t, cmd)
+		modelTyped := updatedModel.(managerModel)
+		assert.Len(t, modelTyped.chosen, 2)
+		assert.Equal(t, tasks[2].Name, modelTyped.chosen[0].Name)
+		assert.Equal(t, tasks[1].Name, modelTyped.chosen[1].Name)
+		assert.False(t, modelTyped.parallel)
+		assert.Equal(t, "Selected: "+tasks[2].Name+", "+tasks[1].Name, modelTyped.View())
+	})
+
+	t.Run("Update - alt+enter runs selected tasks in parallel", func(t *testing.T) {
+		space := tea.KeyMsg{Type: tea.KeySpace}
+		down := tea.KeyMsg{Type: tea.KeyDown}
+
+		updatedModel, _ := model.Update(space)
+		updatedModel, _ = updatedModel.Update(down)
+		updatedModel, _ = updatedModel.Update(space)
+		updatedModel, _ = updatedModel.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true})
+
+		modelTyped := updatedModel.(managerModel)
+		assert.Len(t, modelTyped.chosen, 2)
+		assert.True(t, modelTyped.parallel)
+	})
+
+	t.Run("Update - alt+enter with a single task is not parallel", func(t *testing.T) {
+		updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true})
+
+		modelTyped := updatedModel.(managerModel)
+		assert.Len(t, modelTyped.chosen, 1)
+		assert.False(t, modelTyped.parallel)
 	})
 
 	t.Run("View - basic rendering", func(t *testing.T) {
@@ -218,7 +295,7 @@ func TestManagerModel_AdditionalFeatures(t *testing.T) {
 
 	t.Run("View - with choice selected", func(t *testing.T) {
 		modelWithChoice := model
-		modelWithChoice.choice = task.Task{Name: "build"}
+		modelWithChoice.chosen = []manager.ManagerTask{{Task: task.Task{Name: "build"}}}
 
 		view := modelWithChoice.View()
 		assert.Equal(t, "Selected: build", view)
@@ -244,11 +321,11 @@ func TestManagerModel_AdditionalFeatures(t *testing.T) {
 
 func TestRenderManagerList_ErrorCases(t *testing.T) {
 	t.Run("no tasks provided", func(t *testing.T) {
-		resultManager, resultTask, err := RenderTasksList([]manager.ManagerTask{}, "")
+		resultTasks, parallel, err := RenderTasksList([]manager.ManagerTask{}, "", nil)
 
 		assert.Error(t, err)
-		assert.Nil(t, resultManager)
-		assert.Nil(t, resultTask)
+		assert.Nil(t, resultTasks)
+		assert.False(t, parallel)
 		assert.Contains(t, err.Error(), "no tasks provided")
 	})
 
@@ -260,11 +337,11 @@ func TestRenderManagerList_ErrorCases(t *testing.T) {
 
 	t.Run("empty task list", func(t *testing.T) {
 		// Test with empty task list (which would be the equivalent of "all managers have no tasks")
-		resultManager, resultTask, err := RenderTasksList([]manager.ManagerTask{}, "")
+		resultTasks, parallel, err := RenderTasksList([]manager.ManagerTask{}, "", nil)
 
 		assert.Error(t, err)
-		assert.Nil(t, resultManager)
-		assert.Nil(t, resultTask)
+		assert.Nil(t, resultTasks)
+		assert.False(t, parallel)
 		assert.Contains(t, err.Error(), "no tasks provided")
 	})
 }
@@ -372,3 +449,24 @@ func TestInitialFilter(t *testing.T) {
 		_ = cmd
 	})
 }
+
+func TestManagerModel_Summary(t *testing.T) {
+	var mgr manager.Manager = mocks.NewTaskManagerMock("task", "Taskfile runner", nil)
+	managerTasks := []manager.ManagerTask{
+		{Task: task.Task{Name: "build", Summary: "Builds the binary\nfor the current platform"}, Manager: &mgr},
+		{Task: task.Task{Name: "lint"}, Manager: &mgr},
+	}
+	items := []list.Item{
+		managerTaskItem{ManagerTask: managerTasks[0]},
+		managerTaskItem{ManagerTask: managerTasks[1]},
+	}
+
+	model := managerModel{
+		list:         list.New(items, itemDelegate{}, 80, 14),
+		managerTasks: managerTasks,
+	}
+	assert.Contains(t, model.View(), "Builds the binary")
+
+	updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.NotContains(t, updatedModel.View(), "Builds the binary")
+}